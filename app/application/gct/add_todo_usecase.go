@@ -1,38 +1,108 @@
 package gct
 
 import (
+	"fmt"
+	"time"
+
 	todoDomain "github.com/yanosea/gct/app/domain/todo"
 )
 
 type AddTodoUseCase struct {
-	todoRepo todoDomain.TodoRepository
+	todoRepo    todoDomain.TodoRepository
+	journalRepo todoDomain.JournalRepository
+	idGenerator todoDomain.IDGenerator
+	listOrder   todoDomain.SortSpec
 }
 
 func NewAddTodoUseCase(
 	todoRepo todoDomain.TodoRepository,
+	journalRepo todoDomain.JournalRepository,
+	idGenerator todoDomain.IDGenerator,
+	listOrder todoDomain.SortSpec,
 ) *AddTodoUseCase {
 	return &AddTodoUseCase{
-		todoRepo: todoRepo,
+		todoRepo:    todoRepo,
+		journalRepo: journalRepo,
+		idGenerator: idGenerator,
+		listOrder:   listOrder,
 	}
 }
 
+type AddTodoUsecaseInputDto struct {
+	Title      string
+	Notes      string
+	Priority   string
+	DueDate    string
+	Tags       []string
+	ParentID   string
+	Recurrence string
+}
+
 type AddTodoUsecaseOutputDto struct {
-	ID        string
-	Title     string
-	CreatedAt string
+	ID         string
+	Title      string
+	ParentID   string
+	Notes      string
+	Priority   string
+	Tags       []string
+	DueDate    string
+	Recurrence string
+	CreatedAt  string
 }
 
-func (uc *AddTodoUseCase) Run(title string) (*AddTodoUsecaseOutputDto, error) {
-	todo, err := todoDomain.NewTodo(title)
+func (uc *AddTodoUseCase) Run(input *AddTodoUsecaseInputDto) (*AddTodoUsecaseOutputDto, error) {
+	title, titleTags := todoDomain.ExtractTags(input.Title)
+	todo, err := todoDomain.NewTodo(uc.idGenerator, title)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := todoDomain.NormalizeTags(append(titleTags, input.Tags...))
+	if err != nil {
+		return nil, err
+	}
+	todo.Tags = tags
+	todo.Notes = input.Notes
+	priority, err := todoDomain.ParsePriority(input.Priority)
 	if err != nil {
 		return nil, err
 	}
-	if err := uc.todoRepo.Save(todo); err != nil {
+	todo.Priority = priority
+	dueDate, err := todoDomain.ParseDueDate(input.DueDate, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	todo.DueDate = dueDate
+	if todo.Recurrence, err = todoDomain.ParseRecurrence(input.Recurrence); err != nil {
+		return nil, err
+	}
+	if err := recordJournal(uc.journalRepo, "add", func() ([]*todoDomain.JournalChange, error) {
+		if err := uc.todoRepo.Modify(func(todos []*todoDomain.Todo) ([]*todoDomain.Todo, error) {
+			if input.ParentID != "" {
+				parent, err := todoDomain.ResolveID(todoDomain.ActiveTodos(todos), uc.listOrder, input.ParentID)
+				if err != nil {
+					return nil, fmt.Errorf("parent: %w", err)
+				}
+				if err := todo.SetParent(parent); err != nil {
+					return nil, err
+				}
+			}
+			return append(todos, todo), nil
+		}); err != nil {
+			return nil, err
+		}
+		return []*todoDomain.JournalChange{{After: todo.Clone()}}, nil
+	}); err != nil {
 		return nil, err
 	}
 	return &AddTodoUsecaseOutputDto{
-		ID:        todo.ID,
-		Title:     todo.Title,
-		CreatedAt: todo.CreatedAt.Format("2006-01-02 15:04:05"),
+		ID:         todo.ID,
+		Title:      todo.Title,
+		ParentID:   todo.ParentID,
+		Notes:      todo.Notes,
+		Priority:   string(todo.Priority),
+		Tags:       todo.Tags,
+		DueDate:    formatDueDate(todo.DueDate),
+		Recurrence: string(todo.Recurrence),
+		CreatedAt:  formatCreatedAt(todo.CreatedAt),
 	}, nil
 }
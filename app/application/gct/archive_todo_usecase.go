@@ -0,0 +1,86 @@
+package gct
+
+import (
+	"slices"
+	"sort"
+	"time"
+
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+type ArchiveTodoUseCase struct {
+	todoRepo    todoDomain.TodoRepository
+	archiveRepo todoDomain.ArchiveRepository
+	journalRepo todoDomain.JournalRepository
+}
+
+func NewArchiveTodoUseCase(
+	todoRepo todoDomain.TodoRepository,
+	archiveRepo todoDomain.ArchiveRepository,
+	journalRepo todoDomain.JournalRepository,
+) *ArchiveTodoUseCase {
+	return &ArchiveTodoUseCase{
+		todoRepo:    todoRepo,
+		archiveRepo: archiveRepo,
+		journalRepo: journalRepo,
+	}
+}
+
+type ArchiveTodoUsecaseInputDto struct {
+	OlderThan string
+}
+
+type ArchiveTodoUsecaseOutputDto struct {
+	ID          string
+	Title       string
+	CompletedAt string
+	Period      string
+}
+
+func (uc *ArchiveTodoUseCase) Run(input *ArchiveTodoUsecaseInputDto) ([]*ArchiveTodoUsecaseOutputDto, error) {
+	age, err := todoDomain.ParseAge(input.OlderThan)
+	if err != nil {
+		return nil, err
+	}
+	cutoff := time.Now().Add(-age)
+	archivedDto := make([]*ArchiveTodoUsecaseOutputDto, 0)
+	archivedIDs := make([]string, 0)
+	// the journal is held while the todos are written, like every other change to them
+	if err := uc.journalRepo.Update(func(journal *todoDomain.Journal) error {
+		if err := uc.todoRepo.Modify(func(todos []*todoDomain.Todo) ([]*todoDomain.Todo, error) {
+			periods := make(map[string][]*todoDomain.Todo)
+			for _, t := range todoDomain.ArchivableTodos(todos, cutoff) {
+				periods[t.ArchivePeriod()] = append(periods[t.ArchivePeriod()], t)
+			}
+			keys := make([]string, 0, len(periods))
+			for period := range periods {
+				keys = append(keys, period)
+			}
+			sort.Strings(keys)
+			for _, period := range keys {
+				if err := uc.archiveRepo.Append(period, periods[period]); err != nil {
+					return nil, err
+				}
+				for _, t := range periods[period] {
+					archivedIDs = append(archivedIDs, t.ID)
+					archivedDto = append(archivedDto, &ArchiveTodoUsecaseOutputDto{
+						ID:          t.ID,
+						Title:       t.Title,
+						CompletedAt: formatCompletedAt(t.CompletedAt),
+						Period:      period,
+					})
+				}
+			}
+			return slices.DeleteFunc(todos, func(t *todoDomain.Todo) bool {
+				return slices.Contains(archivedIDs, t.ID)
+			}), nil
+		}); err != nil {
+			return err
+		}
+		journal.Forget(archivedIDs...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return archivedDto, nil
+}
@@ -0,0 +1,66 @@
+package gct
+
+import (
+	"fmt"
+
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+type BlockTodoUseCase struct {
+	todoRepo    todoDomain.TodoRepository
+	journalRepo todoDomain.JournalRepository
+	listOrder   todoDomain.SortSpec
+}
+
+func NewBlockTodoUseCase(
+	todoRepo todoDomain.TodoRepository,
+	journalRepo todoDomain.JournalRepository,
+	listOrder todoDomain.SortSpec,
+) *BlockTodoUseCase {
+	return &BlockTodoUseCase{
+		todoRepo:    todoRepo,
+		journalRepo: journalRepo,
+		listOrder:   listOrder,
+	}
+}
+
+type BlockTodoUsecaseInputDto struct {
+	ID        string
+	BlockerID string
+}
+
+type BlockTodoUsecaseOutputDto struct {
+	ID           string
+	Title        string
+	BlockerID    string
+	BlockerTitle string
+}
+
+func (uc *BlockTodoUseCase) Run(input *BlockTodoUsecaseInputDto) (*BlockTodoUsecaseOutputDto, error) {
+	var todo, blocker, before *todoDomain.Todo
+	if err := recordJournal(uc.journalRepo, "block", func() ([]*todoDomain.JournalChange, error) {
+		if err := uc.todoRepo.Modify(func(todos []*todoDomain.Todo) ([]*todoDomain.Todo, error) {
+			var err error
+			active := todoDomain.ActiveTodos(todos)
+			if todo, err = todoDomain.ResolveID(active, uc.listOrder, input.ID); err != nil {
+				return nil, err
+			}
+			if blocker, err = todoDomain.ResolveID(active, uc.listOrder, input.BlockerID); err != nil {
+				return nil, fmt.Errorf("blocker: %w", err)
+			}
+			before = todo.Clone()
+			return todos, todo.BlockOn(blocker, todos)
+		}); err != nil {
+			return nil, err
+		}
+		return []*todoDomain.JournalChange{{Before: before, After: todo.Clone()}}, nil
+	}); err != nil {
+		return nil, err
+	}
+	return &BlockTodoUsecaseOutputDto{
+		ID:           todo.ID,
+		Title:        todo.Title,
+		BlockerID:    blocker.ID,
+		BlockerTitle: blocker.Title,
+	}, nil
+}
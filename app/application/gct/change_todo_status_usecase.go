@@ -0,0 +1,143 @@
+package gct
+
+import (
+	"time"
+
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+type ChangeTodoStatusUseCase struct {
+	todoRepo    todoDomain.TodoRepository
+	journalRepo todoDomain.JournalRepository
+	idGenerator todoDomain.IDGenerator
+	listOrder   todoDomain.SortSpec
+}
+
+func NewChangeTodoStatusUseCase(
+	todoRepo todoDomain.TodoRepository,
+	journalRepo todoDomain.JournalRepository,
+	idGenerator todoDomain.IDGenerator,
+	listOrder todoDomain.SortSpec,
+) *ChangeTodoStatusUseCase {
+	return &ChangeTodoStatusUseCase{
+		todoRepo:    todoRepo,
+		journalRepo: journalRepo,
+		idGenerator: idGenerator,
+		listOrder:   listOrder,
+	}
+}
+
+type ChangeTodoStatusUsecaseInputDto struct {
+	ID     string
+	Status string
+	Force  bool
+}
+
+type ChangeTodoStatusUsecaseOutputDto struct {
+	ID          string
+	Title       string
+	Notes       string
+	Done        bool
+	Status      string
+	Priority    string
+	Tags        []string
+	DueDate     string
+	Recurrence  string
+	CreatedAt   string
+	NextID      string
+	NextDueDate string
+}
+
+func (uc *ChangeTodoStatusUseCase) Run(input *ChangeTodoStatusUsecaseInputDto) (*ChangeTodoStatusUsecaseOutputDto, error) {
+	status, err := todoDomain.ParseStatus(input.Status)
+	if err != nil {
+		return nil, err
+	}
+	return changeTodoStatus(uc.todoRepo, uc.journalRepo, uc.idGenerator, uc.listOrder, input.ID, func(*todoDomain.Todo) todoDomain.Status {
+		return status
+	}, input.Force, statusOperation(status))
+}
+
+func changeTodoStatus(
+	todoRepo todoDomain.TodoRepository,
+	journalRepo todoDomain.JournalRepository,
+	idGenerator todoDomain.IDGenerator,
+	listOrder todoDomain.SortSpec,
+	id string,
+	target func(todo *todoDomain.Todo) todoDomain.Status,
+	force bool,
+	operation string,
+) (*ChangeTodoStatusUsecaseOutputDto, error) {
+	var todo, before, next *todoDomain.Todo
+	if err := recordJournal(journalRepo, operation, func() ([]*todoDomain.JournalChange, error) {
+		if err := todoRepo.Modify(func(todos []*todoDomain.Todo) ([]*todoDomain.Todo, error) {
+			var err error
+			if todo, err = todoDomain.ResolveID(todoDomain.ActiveTodos(todos), listOrder, id); err != nil {
+				return nil, err
+			}
+			status := target(todo)
+			if status == todoDomain.StatusDone && !force {
+				if blockers := todoDomain.OpenBlockers(todo, todos); len(blockers) > 0 {
+					return nil, &todoDomain.BlockedError{Blockers: blockers}
+				}
+			}
+			before = todo.Clone()
+			now := time.Now()
+			if err := todo.SetStatus(status, now); err != nil {
+				return nil, err
+			}
+			if todo.IsDone() {
+				if next, err = todo.NextOccurrence(idGenerator, now); err != nil {
+					return nil, err
+				}
+				// the rule moves on to the next occurrence so toggling back and forth never spawns twice
+				todo.Recurrence = todoDomain.RecurrenceNone
+			}
+			if next != nil {
+				todos = append(todos, next)
+			}
+			return todos, nil
+		}); err != nil {
+			return nil, err
+		}
+		changes := []*todoDomain.JournalChange{{Before: before, After: todo.Clone()}}
+		if next != nil {
+			changes = append(changes, &todoDomain.JournalChange{After: next.Clone()})
+		}
+		return changes, nil
+	}); err != nil {
+		return nil, err
+	}
+	output := &ChangeTodoStatusUsecaseOutputDto{
+		ID:         todo.ID,
+		Title:      todo.Title,
+		Notes:      todo.Notes,
+		Done:       todo.IsDone(),
+		Status:     string(todo.Status),
+		Priority:   string(todo.Priority),
+		Tags:       todo.Tags,
+		DueDate:    formatDueDate(todo.DueDate),
+		Recurrence: string(before.Recurrence),
+		CreatedAt:  formatCreatedAt(todo.CreatedAt),
+	}
+	if next != nil {
+		output.NextID = next.ID
+		output.NextDueDate = formatDueDate(next.DueDate)
+	}
+	return output, nil
+}
+
+func statusOperation(status todoDomain.Status) string {
+	switch status {
+	case todoDomain.StatusInProgress:
+		return "start"
+	case todoDomain.StatusWaiting:
+		return "wait"
+	case todoDomain.StatusCancelled:
+		return "cancel"
+	case todoDomain.StatusDone:
+		return "complete"
+	default:
+		return "reopen"
+	}
+}
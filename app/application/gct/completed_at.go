@@ -0,0 +1,12 @@
+package gct
+
+import (
+	"time"
+)
+
+func formatCompletedAt(completedAt *time.Time) string {
+	if completedAt == nil {
+		return ""
+	}
+	return completedAt.Format("2006-01-02 15:04:05")
+}
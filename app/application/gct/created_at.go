@@ -0,0 +1,13 @@
+package gct
+
+import (
+	"time"
+)
+
+func formatCreatedAt(createdAt time.Time) string {
+	// todo.txt lines may have no creation date, which is read as the zero time
+	if createdAt.IsZero() {
+		return ""
+	}
+	return createdAt.Format("2006-01-02 15:04:05")
+}
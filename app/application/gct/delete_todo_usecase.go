@@ -1,40 +1,83 @@
 package gct
 
 import (
+	"time"
+
 	todoDomain "github.com/yanosea/gct/app/domain/todo"
 )
 
 type DeleteTodoUseCase struct {
-	todoRepo todoDomain.TodoRepository
+	todoRepo    todoDomain.TodoRepository
+	journalRepo todoDomain.JournalRepository
+	listOrder   todoDomain.SortSpec
 }
 
 func NewDeleteTodoUseCase(
 	todoRepo todoDomain.TodoRepository,
+	journalRepo todoDomain.JournalRepository,
+	listOrder todoDomain.SortSpec,
 ) *DeleteTodoUseCase {
 	return &DeleteTodoUseCase{
-		todoRepo: todoRepo,
+		todoRepo:    todoRepo,
+		journalRepo: journalRepo,
+		listOrder:   listOrder,
 	}
 }
 
 type DeleteTodoUsecaseOutputDto struct {
 	ID        string
 	Title     string
+	Notes     string
 	Done      bool
+	Status    string
+	Priority  string
+	Tags      []string
+	DueDate   string
+	Subtasks  int
 	CreatedAt string
+	DeletedAt string
 }
 
 func (uc *DeleteTodoUseCase) Run(id string) (*DeleteTodoUsecaseOutputDto, error) {
-	todo, err := uc.todoRepo.FindByID(id)
-	if err != nil {
-		return nil, err
-	}
-	if err := uc.todoRepo.Delete(id); err != nil {
+	var todo *todoDomain.Todo
+	var changes []*todoDomain.JournalChange
+	if err := recordJournal(uc.journalRepo, "delete", func() ([]*todoDomain.JournalChange, error) {
+		var trashed []*todoDomain.Todo
+		if err := uc.todoRepo.Modify(func(todos []*todoDomain.Todo) ([]*todoDomain.Todo, error) {
+			var err error
+			active := todoDomain.ActiveTodos(todos)
+			if todo, err = todoDomain.ResolveID(active, uc.listOrder, id); err != nil {
+				return nil, err
+			}
+			now := time.Now()
+			trashed = append([]*todoDomain.Todo{todo}, todoDomain.Descendants(active, todo.ID)...)
+			changes = make([]*todoDomain.JournalChange, len(trashed))
+			for i, t := range trashed {
+				changes[i] = &todoDomain.JournalChange{Before: t.Clone()}
+				t.Trash(now)
+			}
+			return todos, nil
+		}); err != nil {
+			return nil, err
+		}
+		for i, t := range trashed {
+			changes[i].After = t.Clone()
+		}
+		return changes, nil
+	}); err != nil {
 		return nil, err
 	}
 	return &DeleteTodoUsecaseOutputDto{
 		ID:        todo.ID,
 		Title:     todo.Title,
-		Done:      todo.Done,
-		CreatedAt: todo.CreatedAt.Format("2006-01-02 15:04:05"),
+		Notes:     todo.Notes,
+		Done:      todo.IsDone(),
+		Status:    string(todo.Status),
+		Priority:  string(todo.Priority),
+		Tags:      todo.Tags,
+		DueDate:   formatDueDate(todo.DueDate),
+		Subtasks:  len(changes) - 1,
+		CreatedAt: formatCreatedAt(todo.CreatedAt),
+		DeletedAt: todo.DeletedAt.Format("2006-01-02 15:04:05"),
 	}, nil
 }
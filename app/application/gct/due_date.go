@@ -0,0 +1,14 @@
+package gct
+
+import (
+	"time"
+
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+func formatDueDate(dueDate *time.Time) string {
+	if dueDate == nil {
+		return ""
+	}
+	return dueDate.Format(todoDomain.DueDateLayout)
+}
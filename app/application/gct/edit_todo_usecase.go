@@ -0,0 +1,127 @@
+package gct
+
+import (
+	"errors"
+	"time"
+
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+type EditTodoUseCase struct {
+	todoRepo    todoDomain.TodoRepository
+	journalRepo todoDomain.JournalRepository
+	listOrder   todoDomain.SortSpec
+}
+
+func NewEditTodoUseCase(
+	todoRepo todoDomain.TodoRepository,
+	journalRepo todoDomain.JournalRepository,
+	listOrder todoDomain.SortSpec,
+) *EditTodoUseCase {
+	return &EditTodoUseCase{
+		todoRepo:    todoRepo,
+		journalRepo: journalRepo,
+		listOrder:   listOrder,
+	}
+}
+
+type EditTodoUsecaseInputDto struct {
+	ID         string
+	Title      *string
+	Notes      *string
+	Priority   *string
+	DueDate    *string
+	Tags       *[]string
+	Recurrence *string
+}
+
+type EditTodoUsecaseOutputDto struct {
+	ID         string
+	Title      string
+	Notes      string
+	Done       bool
+	Status     string
+	Priority   string
+	Tags       []string
+	DueDate    string
+	Recurrence string
+	CreatedAt  string
+}
+
+func (uc *EditTodoUseCase) Run(input *EditTodoUsecaseInputDto) (*EditTodoUsecaseOutputDto, error) {
+	if input.Title == nil && input.Notes == nil && input.Priority == nil && input.DueDate == nil && input.Tags == nil && input.Recurrence == nil {
+		return nil, errors.New("nothing to edit")
+	}
+	var todo, before *todoDomain.Todo
+	if err := recordJournal(uc.journalRepo, "edit", func() ([]*todoDomain.JournalChange, error) {
+		if err := uc.todoRepo.Modify(func(todos []*todoDomain.Todo) ([]*todoDomain.Todo, error) {
+			var err error
+			if todo, err = todoDomain.ResolveID(todoDomain.ActiveTodos(todos), uc.listOrder, input.ID); err != nil {
+				return nil, err
+			}
+			before = todo.Clone()
+			return todos, editTodo(todo, input)
+		}); err != nil {
+			return nil, err
+		}
+		return []*todoDomain.JournalChange{{Before: before, After: todo.Clone()}}, nil
+	}); err != nil {
+		return nil, err
+	}
+	return &EditTodoUsecaseOutputDto{
+		ID:         todo.ID,
+		Title:      todo.Title,
+		Notes:      todo.Notes,
+		Done:       todo.IsDone(),
+		Status:     string(todo.Status),
+		Priority:   string(todo.Priority),
+		Tags:       todo.Tags,
+		DueDate:    formatDueDate(todo.DueDate),
+		Recurrence: string(todo.Recurrence),
+		CreatedAt:  formatCreatedAt(todo.CreatedAt),
+	}, nil
+}
+
+func editTodo(todo *todoDomain.Todo, input *EditTodoUsecaseInputDto) error {
+	tags := todo.Tags
+	if input.Tags != nil {
+		tags = *input.Tags
+	}
+	if input.Title != nil {
+		title, titleTags := todoDomain.ExtractTags(*input.Title)
+		if err := todo.SetTitle(title); err != nil {
+			return err
+		}
+		tags = append(append([]string{}, tags...), titleTags...)
+	}
+	tags, err := todoDomain.NormalizeTags(tags)
+	if err != nil {
+		return err
+	}
+	todo.Tags = tags
+	if input.Notes != nil {
+		todo.Notes = *input.Notes
+	}
+	if input.Priority != nil {
+		priority, err := todoDomain.ParsePriority(*input.Priority)
+		if err != nil {
+			return err
+		}
+		todo.Priority = priority
+	}
+	if input.DueDate != nil {
+		dueDate, err := todoDomain.ParseDueDate(*input.DueDate, time.Now())
+		if err != nil {
+			return err
+		}
+		todo.DueDate = dueDate
+	}
+	if input.Recurrence != nil {
+		recurrence, err := todoDomain.ParseRecurrence(*input.Recurrence)
+		if err != nil {
+			return err
+		}
+		todo.Recurrence = recurrence
+	}
+	return nil
+}
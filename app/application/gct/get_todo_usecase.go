@@ -0,0 +1,50 @@
+package gct
+
+import (
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+type GetTodoUseCase struct {
+	todoRepo  todoDomain.TodoRepository
+	listOrder todoDomain.SortSpec
+}
+
+func NewGetTodoUseCase(
+	todoRepo todoDomain.TodoRepository,
+	listOrder todoDomain.SortSpec,
+) *GetTodoUseCase {
+	return &GetTodoUseCase{
+		todoRepo:  todoRepo,
+		listOrder: listOrder,
+	}
+}
+
+type GetTodoUsecaseOutputDto struct {
+	ID        string
+	Title     string
+	Notes     string
+	Done      bool
+	Status    string
+	Priority  string
+	Tags      []string
+	DueDate   string
+	CreatedAt string
+}
+
+func (uc *GetTodoUseCase) Run(id string) (*GetTodoUsecaseOutputDto, error) {
+	todo, err := resolveTodo(uc.todoRepo, uc.listOrder, id)
+	if err != nil {
+		return nil, err
+	}
+	return &GetTodoUsecaseOutputDto{
+		ID:        todo.ID,
+		Title:     todo.Title,
+		Notes:     todo.Notes,
+		Done:      todo.IsDone(),
+		Status:    string(todo.Status),
+		Priority:  string(todo.Priority),
+		Tags:      todo.Tags,
+		DueDate:   formatDueDate(todo.DueDate),
+		CreatedAt: formatCreatedAt(todo.CreatedAt),
+	}, nil
+}
@@ -0,0 +1,302 @@
+package gct
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+var importTimeLayouts = []string{"2006-01-02 15:04:05", time.RFC3339, todoDomain.DueDateLayout}
+
+var importColumns = map[string]string{
+	"id":           "id",
+	"title":        "title",
+	"notes":        "notes",
+	"status":       "status",
+	"done":         "done",
+	"priority":     "priority",
+	"tags":         "tags",
+	"parent_id":    "parent_id",
+	"blocked_by":   "blocked_by",
+	"recurrence":   "recurrence",
+	"due":          "due",
+	"due_date":     "due",
+	"created":      "created",
+	"created_at":   "created",
+	"completed_at": "completed_at",
+	"extras":       "extras",
+}
+
+var derivedColumns = []string{"position", "overdue", "due_today", "archived", "depth", "subtasks", "completed", "blockers"}
+
+type ImportTodoUseCase struct {
+	todoRepo    todoDomain.TodoRepository
+	journalRepo todoDomain.JournalRepository
+	idGenerator todoDomain.IDGenerator
+	listOrder   todoDomain.SortSpec
+}
+
+func NewImportTodoUseCase(
+	todoRepo todoDomain.TodoRepository,
+	journalRepo todoDomain.JournalRepository,
+	idGenerator todoDomain.IDGenerator,
+	listOrder todoDomain.SortSpec,
+) *ImportTodoUseCase {
+	return &ImportTodoUseCase{
+		todoRepo:    todoRepo,
+		journalRepo: journalRepo,
+		idGenerator: idGenerator,
+		listOrder:   listOrder,
+	}
+}
+
+type ImportTodoUsecaseInputDto struct {
+	Rows []*ImportTodoUsecaseInputRowDto
+}
+
+type ImportTodoUsecaseInputRowDto struct {
+	Line   int
+	Fields map[string]string
+	Extras map[string]string
+}
+
+type ImportTodoUsecaseOutputDto struct {
+	ID        string
+	Title     string
+	Status    string
+	Priority  string
+	Tags      []string
+	ParentID  string
+	DueDate   string
+	CreatedAt string
+}
+
+type importedTodo struct {
+	todo      *todoDomain.Todo
+	line      int
+	parentID  string
+	blockedBy []string
+}
+
+func (uc *ImportTodoUseCase) Run(input *ImportTodoUsecaseInputDto) ([]*ImportTodoUsecaseOutputDto, error) {
+	if err := validateImportColumns(input.Rows); err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	errs := make([]error, 0)
+	imported := make([]*importedTodo, 0, len(input.Rows))
+	refs := make(map[string]*todoDomain.Todo)
+	for _, row := range input.Rows {
+		item, err := parseImportRow(uc.idGenerator, row.Fields, row.Extras, now)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("line %d: %w", row.Line, err))
+			continue
+		}
+		item.line = row.Line
+		if ref := strings.TrimSpace(row.Fields["id"]); ref != "" {
+			if _, ok := refs[ref]; ok {
+				errs = append(errs, fmt.Errorf("line %d: duplicate id %q", row.Line, ref))
+				continue
+			}
+			refs[ref] = item.todo
+		}
+		imported = append(imported, item)
+	}
+
+	if err := recordJournal(uc.journalRepo, "import", func() ([]*todoDomain.JournalChange, error) {
+		if err := uc.todoRepo.Modify(func(todos []*todoDomain.Todo) ([]*todoDomain.Todo, error) {
+			// references to existing todos are resolved against the file as it is locked, so they cannot go stale
+			existing := todoDomain.ActiveTodos(todos)
+			for _, item := range imported {
+				todos = append(todos, item.todo)
+			}
+			resolve := func(ref string) (*todoDomain.Todo, error) {
+				if t, ok := refs[ref]; ok {
+					return t, nil
+				}
+				return todoDomain.ResolveID(existing, uc.listOrder, ref)
+			}
+			for _, item := range imported {
+				if item.parentID != "" {
+					parent, err := resolve(item.parentID)
+					if err == nil {
+						err = item.todo.SetParent(parent)
+					}
+					if err != nil {
+						errs = append(errs, fmt.Errorf("line %d: parent: %w", item.line, err))
+					}
+				}
+				for _, ref := range item.blockedBy {
+					blocker, err := resolve(ref)
+					if err == nil {
+						err = item.todo.BlockOn(blocker, todos)
+					}
+					if err != nil {
+						errs = append(errs, fmt.Errorf("line %d: blocked by: %w", item.line, err))
+					}
+				}
+			}
+			if len(errs) > 0 {
+				return nil, errors.Join(errs...)
+			}
+			if len(imported) == 0 {
+				return nil, errors.New("no todos to import")
+			}
+			return todos, nil
+		}); err != nil {
+			return nil, err
+		}
+		changes := make([]*todoDomain.JournalChange, 0, len(imported))
+		for _, item := range imported {
+			changes = append(changes, &todoDomain.JournalChange{After: item.todo.Clone()})
+		}
+		return changes, nil
+	}); err != nil {
+		return nil, err
+	}
+	outputDtos := make([]*ImportTodoUsecaseOutputDto, 0, len(imported))
+	for _, item := range imported {
+		t := item.todo
+		outputDtos = append(outputDtos, &ImportTodoUsecaseOutputDto{
+			ID:        t.ID,
+			Title:     t.Title,
+			Status:    string(t.Status),
+			Priority:  string(t.Priority),
+			Tags:      t.Tags,
+			ParentID:  t.ParentID,
+			DueDate:   formatDueDate(t.DueDate),
+			CreatedAt: formatCreatedAt(t.CreatedAt),
+		})
+	}
+	return outputDtos, nil
+}
+
+func validateImportColumns(rows []*ImportTodoUsecaseInputRowDto) error {
+	unknown := make([]string, 0)
+	for _, row := range rows {
+		for column := range row.Fields {
+			if _, ok := importColumns[column]; ok || slices.Contains(derivedColumns, column) || slices.Contains(unknown, column) {
+				continue
+			}
+			unknown = append(unknown, column)
+		}
+	}
+	if len(unknown) == 0 {
+		return nil
+	}
+	slices.Sort(unknown)
+	known := make([]string, 0, len(importColumns))
+	for column := range importColumns {
+		known = append(known, column)
+	}
+	slices.Sort(known)
+	return fmt.Errorf("unknown column %s (expected %s)", strings.Join(quoteAll(unknown), ", "), strings.Join(known, ", "))
+}
+
+func parseImportRow(idGenerator todoDomain.IDGenerator, fields map[string]string, extras map[string]string, now time.Time) (*importedTodo, error) {
+	values := make(map[string]string, len(fields))
+	for column, value := range fields {
+		if field, ok := importColumns[column]; ok && strings.TrimSpace(value) != "" {
+			values[field] = value
+		}
+	}
+	t, err := todoDomain.NewTodo(idGenerator, strings.TrimSpace(values["title"]))
+	if err != nil {
+		return nil, err
+	}
+	t.Notes = values["notes"]
+	if t.Priority, err = todoDomain.ParsePriority(strings.TrimSpace(values["priority"])); err != nil {
+		return nil, err
+	}
+	if t.Tags, err = todoDomain.NormalizeTags(splitImportList(values["tags"])); err != nil {
+		return nil, err
+	}
+	if t.Recurrence, err = todoDomain.ParseRecurrence(strings.TrimSpace(values["recurrence"])); err != nil {
+		return nil, err
+	}
+	if t.DueDate, err = todoDomain.ParseDueDate(values["due"], now); err != nil {
+		return nil, err
+	}
+	if value, ok := values["created"]; ok {
+		if t.CreatedAt, err = parseImportTime("created", value, now); err != nil {
+			return nil, err
+		}
+	}
+
+	status := todoDomain.StatusTodo
+	if value, ok := values["status"]; ok {
+		if status, err = todoDomain.ParseStatus(strings.TrimSpace(value)); err != nil {
+			return nil, err
+		}
+	} else if value, ok := values["done"]; ok {
+		switch strings.ToLower(strings.TrimSpace(value)) {
+		case "true", "yes", "1":
+			status = todoDomain.StatusDone
+		case "false", "no", "0":
+		default:
+			return nil, fmt.Errorf("invalid done value %q (expected true or false)", value)
+		}
+	}
+	if status != todoDomain.StatusTodo {
+		if err := t.SetStatus(status, now); err != nil {
+			return nil, err
+		}
+	}
+	if value, ok := values["completed_at"]; ok {
+		if !t.IsClosed() {
+			return nil, errors.New("completed_at is set on a todo that is not done or cancelled")
+		}
+		completedAt, err := parseImportTime("completed_at", value, now)
+		if err != nil {
+			return nil, err
+		}
+		t.CompletedAt = &completedAt
+	}
+
+	t.Extras = maps.Clone(extras)
+	for _, extra := range splitImportList(values["extras"]) {
+		key, value, ok := strings.Cut(extra, ":")
+		if !ok || key == "" || value == "" {
+			return nil, fmt.Errorf("invalid extra %q (expected key:value)", extra)
+		}
+		if t.Extras == nil {
+			t.Extras = make(map[string]string)
+		}
+		t.Extras[key] = value
+	}
+
+	return &importedTodo{
+		todo:      t,
+		parentID:  strings.TrimSpace(values["parent_id"]),
+		blockedBy: splitImportList(values["blocked_by"]),
+	}, nil
+}
+
+func parseImportTime(column string, value string, now time.Time) (time.Time, error) {
+	for _, layout := range importTimeLayouts {
+		if t, err := time.ParseInLocation(layout, strings.TrimSpace(value), now.Location()); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid %s %q (expected YYYY-MM-DD or YYYY-MM-DD HH:MM:SS)", column, value)
+}
+
+func splitImportList(value string) []string {
+	return strings.FieldsFunc(value, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ','
+	})
+}
+
+func quoteAll(values []string) []string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = fmt.Sprintf("%q", value)
+	}
+	return quoted
+}
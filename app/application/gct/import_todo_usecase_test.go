@@ -0,0 +1,121 @@
+package gct
+
+import (
+	"strings"
+	"testing"
+
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+func importRow(line int, fields map[string]string) *ImportTodoUsecaseInputRowDto {
+	return &ImportTodoUsecaseInputRowDto{Line: line, Fields: fields}
+}
+
+func TestImportTodoUseCaseRowErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		rows     []*ImportTodoUsecaseInputRowDto
+		wantErrs []string
+	}{
+		{
+			name: "invalid values",
+			rows: []*ImportTodoUsecaseInputRowDto{
+				importRow(2, map[string]string{"title": "fine"}),
+				importRow(3, map[string]string{"title": " "}),
+				importRow(4, map[string]string{"title": "urgent", "priority": "urgent"}),
+				importRow(5, map[string]string{"title": "someday", "due": "someday"}),
+				importRow(6, map[string]string{"title": "open", "status": "todo", "completed_at": "2026-10-01"}),
+			},
+			wantErrs: []string{"line 3: title is empty", `line 4: invalid priority "urgent"`, "line 5: ", "line 6: completed_at is set on a todo that is not done or cancelled"},
+		},
+		{
+			name: "duplicate id",
+			rows: []*ImportTodoUsecaseInputRowDto{
+				importRow(2, map[string]string{"title": "first", "id": "a"}),
+				importRow(3, map[string]string{"title": "second", "id": "a"}),
+			},
+			wantErrs: []string{`line 3: duplicate id "a"`},
+		},
+		{
+			name: "unresolved references",
+			rows: []*ImportTodoUsecaseInputRowDto{
+				importRow(2, map[string]string{"title": "orphan", "parent_id": "missing"}),
+				importRow(3, map[string]string{"title": "waiting", "blocked_by": "missing"}),
+				importRow(4, map[string]string{"title": "trashed parent", "parent_id": "gone"}),
+			},
+			wantErrs: []string{"line 2: parent: todo not found", "line 3: blocked by: todo not found", "line 4: parent: todo not found"},
+		},
+		{
+			name: "dependency cycle",
+			rows: []*ImportTodoUsecaseInputRowDto{
+				importRow(2, map[string]string{"title": "self", "id": "s", "blocked_by": "s"}),
+				importRow(3, map[string]string{"title": "first", "id": "a", "blocked_by": "b"}),
+				importRow(4, map[string]string{"title": "second", "id": "b", "blocked_by": "a"}),
+			},
+			wantErrs: []string{"line 2: blocked by: a todo cannot block itself", "line 4: blocked by: " + todoDomain.ErrDependencyCycle.Error()},
+		},
+		{
+			name:     "no rows",
+			rows:     []*ImportTodoUsecaseInputRowDto{},
+			wantErrs: []string{"no todos to import"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gone := testTodo("gone", "trashed")
+			gone.Trash(gone.CreatedAt)
+			todoRepo := newMemoryTodoRepository(testTodo("k", "existing"), gone)
+			journalRepo := &memoryJournalRepository{}
+			uc := NewImportTodoUseCase(todoRepo, journalRepo, todoDomain.NewShortIDGenerator(), nil)
+
+			_, err := uc.Run(&ImportTodoUsecaseInputDto{Rows: tt.rows})
+			if err == nil {
+				t.Fatal("Run returned no error")
+			}
+			for _, want := range tt.wantErrs {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("error %q does not report %q", err, want)
+				}
+			}
+			if got := strings.Count(err.Error(), "\n") + 1; got != len(tt.wantErrs) {
+				t.Errorf("error reports %d problems, want %d:\n%s", got, len(tt.wantErrs), err)
+			}
+			if len(todoRepo.todos) != 2 {
+				t.Errorf("%d todos stored after a failed import, want the 2 there were", len(todoRepo.todos))
+			}
+			if len(journalRepo.journal.Undo) != 0 {
+				t.Error("a failed import was recorded in the journal")
+			}
+		})
+	}
+}
+
+func TestImportTodoUseCaseResolvesReferences(t *testing.T) {
+	todoRepo := newMemoryTodoRepository(testTodo("k", "existing"))
+	journalRepo := &memoryJournalRepository{}
+	uc := NewImportTodoUseCase(todoRepo, journalRepo, todoDomain.NewShortIDGenerator(), nil)
+
+	output, err := uc.Run(&ImportTodoUsecaseInputDto{Rows: []*ImportTodoUsecaseInputRowDto{
+		importRow(2, map[string]string{"title": "child", "id": "c", "parent_id": "1"}),
+		importRow(3, map[string]string{"title": "grandchild", "parent_id": "c", "blocked_by": "k,c"}),
+	}})
+	if err != nil {
+		t.Fatalf("Run returned error: %v", err)
+	}
+	if len(output) != 2 || len(todoRepo.todos) != 3 {
+		t.Fatalf("imported %d todos and stored %d, want 2 and 3", len(output), len(todoRepo.todos))
+	}
+	child, grandchild := todoRepo.todos[1], todoRepo.todos[2]
+	if child.ID == "c" {
+		t.Error("the id column was kept as the todo ID instead of a new one")
+	}
+	if child.ParentID != "k" {
+		t.Errorf("child parent = %q, want the existing todo at position 1", child.ParentID)
+	}
+	if grandchild.ParentID != child.ID || strings.Join(grandchild.BlockedBy, ",") != "k,"+child.ID {
+		t.Errorf("grandchild parent = %q and blocked by %v, want references to the imported child", grandchild.ParentID, grandchild.BlockedBy)
+	}
+	if len(journalRepo.journal.Undo) != 1 || len(journalRepo.journal.Undo[0].Changes) != 2 {
+		t.Error("the import was not recorded as one journal entry")
+	}
+}
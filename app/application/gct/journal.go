@@ -0,0 +1,65 @@
+package gct
+
+import (
+	"fmt"
+	"slices"
+
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+// recordJournal holds the journal while the todos are written, so entries are recorded in the order the changes were made.
+func recordJournal(journalRepo todoDomain.JournalRepository, operation string, change func() ([]*todoDomain.JournalChange, error)) error {
+	return journalRepo.Update(func(journal *todoDomain.Journal) error {
+		changes, err := change()
+		if err != nil {
+			return err
+		}
+		journal.Record(todoDomain.NewJournalEntry(operation, changes...))
+		return nil
+	})
+}
+
+func applyJournalEntry(todoRepo todoDomain.TodoRepository, entry *todoDomain.JournalEntry, reverse bool) error {
+	return todoRepo.Modify(func(todos []*todoDomain.Todo) ([]*todoDomain.Todo, error) {
+		for i := range entry.Changes {
+			change := entry.Changes[i]
+			from, to := change.Before, change.After
+			if reverse {
+				change = entry.Changes[len(entry.Changes)-1-i]
+				from, to = change.After, change.Before
+			}
+			subject := to
+			if subject == nil {
+				subject = from
+			}
+			index := slices.IndexFunc(todos, func(t *todoDomain.Todo) bool { return t.ID == subject.ID })
+			switch {
+			case from == nil && index >= 0:
+				return nil, fmt.Errorf("todo with id %q already exists", to.ID)
+			case from == nil:
+				todos = append(todos, to.Clone())
+			case index < 0:
+				return nil, todoDomain.ErrTodoNotFound
+			case !todos[index].Equal(from):
+				return nil, fmt.Errorf("todo %q has changed since the %s", from.ID, entry.Operation)
+			case to == nil:
+				todos = slices.Delete(todos, index, index+1)
+			default:
+				todos[index] = to.Clone()
+			}
+		}
+		return todos, nil
+	})
+}
+
+func journalEntrySubject(entry *todoDomain.JournalEntry) *todoDomain.Todo {
+	for _, change := range entry.Changes {
+		if change.Before != nil {
+			return change.Before
+		}
+		if change.After != nil {
+			return change.After
+		}
+	}
+	return &todoDomain.Todo{}
+}
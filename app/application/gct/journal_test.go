@@ -0,0 +1,220 @@
+package gct
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+// memoryTodoRepository keeps clones, so that only what a use case writes through Modify is stored.
+type memoryTodoRepository struct {
+	todos []*todoDomain.Todo
+}
+
+func newMemoryTodoRepository(todos ...*todoDomain.Todo) *memoryTodoRepository {
+	return &memoryTodoRepository{todos: cloneTodos(todos)}
+}
+
+func (r *memoryTodoRepository) FindAll() ([]*todoDomain.Todo, error) {
+	return cloneTodos(r.todos), nil
+}
+
+func (r *memoryTodoRepository) Modify(fn func(todos []*todoDomain.Todo) ([]*todoDomain.Todo, error)) error {
+	todos, err := fn(cloneTodos(r.todos))
+	if err != nil {
+		return err
+	}
+	r.todos = cloneTodos(todos)
+	return nil
+}
+
+type memoryJournalRepository struct {
+	journal todoDomain.Journal
+}
+
+func (r *memoryJournalRepository) Load() (*todoDomain.Journal, error) {
+	journal := r.journal
+	return &journal, nil
+}
+
+func (r *memoryJournalRepository) Update(fn func(journal *todoDomain.Journal) error) error {
+	journal := r.journal
+	if err := fn(&journal); err != nil {
+		return err
+	}
+	r.journal = journal
+	return nil
+}
+
+func cloneTodos(todos []*todoDomain.Todo) []*todoDomain.Todo {
+	clones := make([]*todoDomain.Todo, len(todos))
+	for i, t := range todos {
+		clones[i] = t.Clone()
+	}
+	return clones
+}
+
+func testTodo(id string, title string) *todoDomain.Todo {
+	return &todoDomain.Todo{
+		ID:        id,
+		Title:     title,
+		Status:    todoDomain.StatusTodo,
+		CreatedAt: time.Date(2026, 10, 1, 9, 0, 0, 0, time.Local),
+	}
+}
+
+func titles(todos []*todoDomain.Todo) string {
+	names := make([]string, len(todos))
+	for i, t := range todos {
+		names[i] = t.Title
+	}
+	return strings.Join(names, ",")
+}
+
+func TestApplyJournalEntry(t *testing.T) {
+	before := testTodo("a", "write report")
+	after := before.Clone()
+	after.Title = "write summary"
+	added := testTodo("b", "buy milk")
+	trashedAt := time.Date(2026, 10, 2, 9, 0, 0, 0, time.Local)
+	trashed := after.Clone()
+	trashed.DeletedAt = &trashedAt
+
+	tests := []struct {
+		name    string
+		stored  []*todoDomain.Todo
+		changes []*todoDomain.JournalChange
+		reverse bool
+		want    string
+		wantErr string
+	}{
+		{
+			name:    "undo an edit",
+			stored:  []*todoDomain.Todo{after},
+			changes: []*todoDomain.JournalChange{{Before: before, After: after}},
+			reverse: true,
+			want:    "write report",
+		},
+		{
+			name:    "redo an edit",
+			stored:  []*todoDomain.Todo{before},
+			changes: []*todoDomain.JournalChange{{Before: before, After: after}},
+			want:    "write summary",
+		},
+		{
+			name:    "undo an add",
+			stored:  []*todoDomain.Todo{before, added},
+			changes: []*todoDomain.JournalChange{{After: added}},
+			reverse: true,
+			want:    "write report",
+		},
+		{
+			name:    "redo an add",
+			stored:  []*todoDomain.Todo{before},
+			changes: []*todoDomain.JournalChange{{After: added}},
+			want:    "write report,buy milk",
+		},
+		{
+			name:    "undo changes in reverse order",
+			stored:  []*todoDomain.Todo{trashed, added},
+			changes: []*todoDomain.JournalChange{{Before: before, After: after}, {Before: after, After: trashed}, {After: added}},
+			reverse: true,
+			want:    "write report",
+		},
+		{
+			name:    "todo changed since the edit",
+			stored:  []*todoDomain.Todo{trashed},
+			changes: []*todoDomain.JournalChange{{Before: before, After: after}},
+			reverse: true,
+			wantErr: `todo "a" has changed since the edit`,
+		},
+		{
+			name:    "todo removed since the edit",
+			stored:  []*todoDomain.Todo{added},
+			changes: []*todoDomain.JournalChange{{Before: before, After: after}},
+			reverse: true,
+			wantErr: todoDomain.ErrTodoNotFound.Error(),
+		},
+		{
+			name:    "redo an add of a todo that exists",
+			stored:  []*todoDomain.Todo{added},
+			changes: []*todoDomain.JournalChange{{After: added}},
+			wantErr: `todo with id "b" already exists`,
+		},
+		{
+			name:    "conflict leaves earlier changes of the entry unapplied",
+			stored:  []*todoDomain.Todo{before, added},
+			changes: []*todoDomain.JournalChange{{After: added}, {Before: before, After: after}},
+			reverse: true,
+			wantErr: `todo "a" has changed since the edit`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newMemoryTodoRepository(tt.stored...)
+			entry := todoDomain.NewJournalEntry("edit", tt.changes...)
+			err := applyJournalEntry(repo, entry, tt.reverse)
+			if tt.wantErr != "" {
+				if err == nil || err.Error() != tt.wantErr {
+					t.Fatalf("applyJournalEntry error = %v, want %q", err, tt.wantErr)
+				}
+				if got := titles(repo.todos); got != titles(tt.stored) {
+					t.Errorf("todos = %s after a failed entry, want them untouched as %s", got, titles(tt.stored))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("applyJournalEntry returned error: %v", err)
+			}
+			if got := titles(repo.todos); got != tt.want {
+				t.Errorf("todos = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUndoAfterChangeElsewhere(t *testing.T) {
+	todoRepo := newMemoryTodoRepository(testTodo("a", "write report"))
+	journalRepo := &memoryJournalRepository{}
+	title := "write summary"
+	if _, err := NewEditTodoUseCase(todoRepo, journalRepo, nil).Run(&EditTodoUsecaseInputDto{ID: "a", Title: &title}); err != nil {
+		t.Fatalf("edit returned error: %v", err)
+	}
+	todoRepo.todos[0].Notes = "edited by hand"
+
+	if _, err := NewUndoTodoUseCase(todoRepo, journalRepo).Run(); err == nil || !strings.Contains(err.Error(), "has changed since the edit") {
+		t.Fatalf("undo error = %v, want a conflict", err)
+	}
+	if todoRepo.todos[0].Title != title {
+		t.Errorf("title = %q after a conflicting undo, want %q", todoRepo.todos[0].Title, title)
+	}
+	if len(journalRepo.journal.Undo) != 1 || len(journalRepo.journal.Redo) != 0 {
+		t.Errorf("journal has %d undo and %d redo entries, want the entry kept for undo", len(journalRepo.journal.Undo), len(journalRepo.journal.Redo))
+	}
+
+	todoRepo.todos[0].Notes = ""
+	if _, err := NewUndoTodoUseCase(todoRepo, journalRepo).Run(); err != nil {
+		t.Fatalf("undo returned error: %v", err)
+	}
+	if _, err := NewRedoTodoUseCase(todoRepo, journalRepo).Run(); err != nil {
+		t.Fatalf("redo returned error: %v", err)
+	}
+	if todoRepo.todos[0].Title != title {
+		t.Errorf("title = %q after redo, want %q", todoRepo.todos[0].Title, title)
+	}
+}
+
+func TestFailedChangeIsNotRecorded(t *testing.T) {
+	todoRepo := newMemoryTodoRepository(testTodo("a", "write report"))
+	journalRepo := &memoryJournalRepository{}
+	title := " "
+	if _, err := NewEditTodoUseCase(todoRepo, journalRepo, nil).Run(&EditTodoUsecaseInputDto{ID: "a", Title: &title}); err == nil {
+		t.Fatal("edit with an empty title returned no error")
+	}
+	if _, err := NewUndoTodoUseCase(todoRepo, journalRepo).Run(); !errors.Is(err, todoDomain.ErrNothingToUndo) {
+		t.Errorf("undo error = %v, want %v", err, todoDomain.ErrNothingToUndo)
+	}
+}
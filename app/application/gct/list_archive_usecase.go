@@ -0,0 +1,53 @@
+package gct
+
+import (
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+type ListArchiveUseCase struct {
+	archiveRepo todoDomain.ArchiveRepository
+}
+
+func NewListArchiveUseCase(
+	archiveRepo todoDomain.ArchiveRepository,
+) *ListArchiveUseCase {
+	return &ListArchiveUseCase{
+		archiveRepo: archiveRepo,
+	}
+}
+
+type ListArchiveUsecaseOutputDto struct {
+	ID          string
+	Title       string
+	Notes       string
+	Status      string
+	Priority    string
+	Tags        []string
+	DueDate     string
+	CreatedAt   string
+	CompletedAt string
+	Period      string
+}
+
+func (uc *ListArchiveUseCase) Run() ([]*ListArchiveUsecaseOutputDto, error) {
+	archived, err := uc.archiveRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	archiveDto := make([]*ListArchiveUsecaseOutputDto, len(archived))
+	for i, t := range archived {
+		archiveDto[i] = &ListArchiveUsecaseOutputDto{
+			ID:          t.ID,
+			Title:       t.Title,
+			Notes:       t.Notes,
+			Status:      string(t.Status),
+			Priority:    string(t.Priority),
+			Tags:        t.Tags,
+			DueDate:     formatDueDate(t.DueDate),
+			CreatedAt:   formatCreatedAt(t.CreatedAt),
+			CompletedAt: formatCompletedAt(t.CompletedAt),
+			Period:      t.ArchivePeriod(),
+		}
+	}
+	return archiveDto, nil
+}
@@ -0,0 +1,48 @@
+package gct
+
+import (
+	"sort"
+
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+type ListTagsUseCase struct {
+	todoRepo todoDomain.TodoRepository
+}
+
+func NewListTagsUseCase(
+	todoRepo todoDomain.TodoRepository,
+) *ListTagsUseCase {
+	return &ListTagsUseCase{
+		todoRepo: todoRepo,
+	}
+}
+
+type ListTagsUsecaseOutputDto struct {
+	Tag   string
+	Count int
+}
+
+func (uc *ListTagsUseCase) Run() ([]*ListTagsUsecaseOutputDto, error) {
+	todos, err := uc.todoRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	counts := make(map[string]int)
+	for _, t := range todoDomain.ActiveTodos(todos) {
+		for _, tag := range t.Tags {
+			counts[tag]++
+		}
+	}
+	tagDto := make([]*ListTagsUsecaseOutputDto, 0, len(counts))
+	for tag, count := range counts {
+		tagDto = append(tagDto, &ListTagsUsecaseOutputDto{
+			Tag:   tag,
+			Count: count,
+		})
+	}
+	sort.Slice(tagDto, func(i, j int) bool {
+		return tagDto[i].Tag < tagDto[j].Tag
+	})
+	return tagDto, nil
+}
@@ -1,41 +1,149 @@
 package gct
 
 import (
+	"time"
+
 	todoDomain "github.com/yanosea/gct/app/domain/todo"
 )
 
 type ListTodoUseCase struct {
-	todoRepo todoDomain.TodoRepository
+	todoRepo    todoDomain.TodoRepository
+	archiveRepo todoDomain.ArchiveRepository
+	listOrder   todoDomain.SortSpec
 }
 
 func NewListTodoUseCase(
 	todoRepo todoDomain.TodoRepository,
+	archiveRepo todoDomain.ArchiveRepository,
+	listOrder todoDomain.SortSpec,
 ) *ListTodoUseCase {
 	return &ListTodoUseCase{
-		todoRepo: todoRepo,
+		todoRepo:    todoRepo,
+		archiveRepo: archiveRepo,
+		listOrder:   listOrder,
 	}
 }
 
+type ListTodoUsecaseInputDto struct {
+	Sort            string
+	Tags            []string
+	MatchAnyTag     bool
+	IncludeArchived bool
+	Tree            bool
+	Query           string
+}
+
 type ListTodoUsecaseOutputDto struct {
-	ID        string
-	Title     string
-	Done      bool
-	CreatedAt string
+	Position    int
+	ID          string
+	Title       string
+	Notes       string
+	Done        bool
+	Status      string
+	Priority    string
+	Tags        []string
+	DueDate     string
+	Overdue     bool
+	DueToday    bool
+	Archived    bool
+	ParentID    string
+	Depth       int
+	Subtasks    int
+	Completed   int
+	Recurrence  string
+	BlockedBy   []string
+	Blockers    []string
+	CreatedAt   string
+	CompletedAt string
+	Extras      map[string]string
 }
 
-func (uc *ListTodoUseCase) Run() ([]*ListTodoUsecaseOutputDto, error) {
-	todo, err := uc.todoRepo.FindAll()
+func (uc *ListTodoUseCase) Run(input *ListTodoUsecaseInputDto) ([]*ListTodoUsecaseOutputDto, error) {
+	todos, err := uc.todoRepo.FindAll()
 	if err != nil {
 		return nil, err
 	}
-	todoDto := make([]*ListTodoUsecaseOutputDto, len(todo))
-	for i, t := range todo {
+	tags, err := todoDomain.NormalizeTags(input.Tags)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	query, err := todoDomain.ParseQuery(input.Query, now)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := todoDomain.ParseSortSpec(input.Sort)
+	if err != nil {
+		return nil, err
+	}
+	active := todoDomain.ActiveTodos(todos)
+	items := treeItems(filterTodos(active, tags, query, spec, input), input.Tree)
+	archivedFrom := len(items)
+	if input.IncludeArchived {
+		archived, err := uc.archiveRepo.FindAll()
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, treeItems(filterTodos(archived, tags, query, spec, input), input.Tree)...)
+		active = append(active, archived...)
+	}
+	positions := uc.listOrder.Positions(todoDomain.ActiveTodos(todos))
+	progress := todoDomain.ChildProgress(active)
+
+	todoDto := make([]*ListTodoUsecaseOutputDto, len(items))
+	for i, item := range items {
+		t := item.Todo
 		todoDto[i] = &ListTodoUsecaseOutputDto{
-			ID:        t.ID,
-			Title:     t.Title,
-			Done:      t.Done,
-			CreatedAt: t.CreatedAt.Format("2006-01-02 15:04:05"),
+			Position:    positions[t.ID],
+			ID:          t.ID,
+			Title:       t.Title,
+			Notes:       t.Notes,
+			Done:        t.IsDone(),
+			Status:      string(t.Status),
+			Priority:    string(t.Priority),
+			Tags:        t.Tags,
+			DueDate:     formatDueDate(t.DueDate),
+			Overdue:     t.IsOverdue(now),
+			DueToday:    t.IsDueToday(now),
+			Archived:    i >= archivedFrom,
+			ParentID:    t.ParentID,
+			Depth:       item.Depth,
+			Subtasks:    progress[t.ID].Total,
+			Completed:   progress[t.ID].Done,
+			Recurrence:  string(t.Recurrence),
+			CreatedAt:   formatCreatedAt(t.CreatedAt),
+			CompletedAt: formatCompletedAt(t.CompletedAt),
+			Extras:      t.Extras,
+		}
+		if t.IsClosed() {
+			continue
+		}
+		for _, blocker := range todoDomain.OpenBlockers(t, todos) {
+			todoDto[i].BlockedBy = append(todoDto[i].BlockedBy, blocker.ID)
+			todoDto[i].Blockers = append(todoDto[i].Blockers, blocker.Title)
 		}
 	}
 	return todoDto, nil
 }
+
+func filterTodos(todos []*todoDomain.Todo, tags []string, query *todoDomain.Query, spec todoDomain.SortSpec, input *ListTodoUsecaseInputDto) []*todoDomain.Todo {
+	filtered := make([]*todoDomain.Todo, 0, len(todos))
+	for _, t := range todos {
+		if t.MatchTags(tags, input.MatchAnyTag) && query.Matches(t) {
+			filtered = append(filtered, t)
+		}
+	}
+	spec.Sort(filtered)
+	return filtered
+}
+
+func treeItems(todos []*todoDomain.Todo, tree bool) []*todoDomain.TreeItem {
+	if tree {
+		return todoDomain.FlattenTree(todos)
+	}
+	items := make([]*todoDomain.TreeItem, len(todos))
+	for i, t := range todos {
+		items[i] = &todoDomain.TreeItem{Todo: t}
+	}
+	return items
+}
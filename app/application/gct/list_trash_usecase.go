@@ -0,0 +1,59 @@
+package gct
+
+import (
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+type ListTrashUseCase struct {
+	todoRepo  todoDomain.TodoRepository
+	listOrder todoDomain.SortSpec
+}
+
+func NewListTrashUseCase(
+	todoRepo todoDomain.TodoRepository,
+	listOrder todoDomain.SortSpec,
+) *ListTrashUseCase {
+	return &ListTrashUseCase{
+		todoRepo:  todoRepo,
+		listOrder: listOrder,
+	}
+}
+
+type ListTrashUsecaseOutputDto struct {
+	Position  int
+	ID        string
+	Title     string
+	Notes     string
+	Done      bool
+	Status    string
+	Priority  string
+	Tags      []string
+	DueDate   string
+	CreatedAt string
+	DeletedAt string
+}
+
+func (uc *ListTrashUseCase) Run() ([]*ListTrashUsecaseOutputDto, error) {
+	todos, err := uc.todoRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	trashed := uc.listOrder.Sorted(todoDomain.TrashedTodos(todos))
+	trashDto := make([]*ListTrashUsecaseOutputDto, len(trashed))
+	for i, t := range trashed {
+		trashDto[i] = &ListTrashUsecaseOutputDto{
+			Position:  i + 1,
+			ID:        t.ID,
+			Title:     t.Title,
+			Notes:     t.Notes,
+			Done:      t.IsDone(),
+			Status:    string(t.Status),
+			Priority:  string(t.Priority),
+			Tags:      t.Tags,
+			DueDate:   formatDueDate(t.DueDate),
+			CreatedAt: formatCreatedAt(t.CreatedAt),
+			DeletedAt: t.DeletedAt.Format("2006-01-02 15:04:05"),
+		}
+	}
+	return trashDto, nil
+}
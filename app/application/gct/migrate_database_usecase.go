@@ -0,0 +1,51 @@
+package gct
+
+import (
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+type MigrateDatabaseUseCase struct {
+	todoMigrator todoDomain.TodoMigrator
+}
+
+func NewMigrateDatabaseUseCase(
+	todoMigrator todoDomain.TodoMigrator,
+) *MigrateDatabaseUseCase {
+	return &MigrateDatabaseUseCase{
+		todoMigrator: todoMigrator,
+	}
+}
+
+type MigrateDatabaseUsecaseOutputDto struct {
+	CurrentVersion int
+	TargetVersion  int
+	Migrations     []string
+	TodoCount      int
+	BackupFilePath string
+	DryRun         bool
+}
+
+func (uc *MigrateDatabaseUseCase) Run(dryRun bool) (*MigrateDatabaseUsecaseOutputDto, error) {
+	var plan *todoDomain.MigrationPlan
+	var err error
+	if dryRun {
+		plan, err = uc.todoMigrator.Plan()
+	} else {
+		plan, err = uc.todoMigrator.Migrate()
+	}
+	if err != nil {
+		return nil, err
+	}
+	migrations := make([]string, len(plan.Steps))
+	for i, step := range plan.Steps {
+		migrations[i] = step.Description
+	}
+	return &MigrateDatabaseUsecaseOutputDto{
+		CurrentVersion: plan.CurrentVersion,
+		TargetVersion:  plan.TargetVersion,
+		Migrations:     migrations,
+		TodoCount:      plan.TodoCount,
+		BackupFilePath: plan.BackupFilePath,
+		DryRun:         dryRun,
+	}, nil
+}
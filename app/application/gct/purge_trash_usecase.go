@@ -0,0 +1,75 @@
+package gct
+
+import (
+	"errors"
+	"time"
+
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+type PurgeTrashUseCase struct {
+	todoRepo    todoDomain.TodoRepository
+	journalRepo todoDomain.JournalRepository
+}
+
+func NewPurgeTrashUseCase(
+	todoRepo todoDomain.TodoRepository,
+	journalRepo todoDomain.JournalRepository,
+) *PurgeTrashUseCase {
+	return &PurgeTrashUseCase{
+		todoRepo:    todoRepo,
+		journalRepo: journalRepo,
+	}
+}
+
+type PurgeTrashUsecaseInputDto struct {
+	OlderThan string
+	All       bool
+}
+
+type PurgeTrashUsecaseOutputDto struct {
+	ID        string
+	Title     string
+	DeletedAt string
+}
+
+func (uc *PurgeTrashUseCase) Run(input *PurgeTrashUsecaseInputDto) ([]*PurgeTrashUsecaseOutputDto, error) {
+	switch {
+	case input.All && input.OlderThan != "":
+		return nil, errors.New("--all and --older-than cannot be used together")
+	case !input.All && input.OlderThan == "":
+		return nil, errors.New("pass --older-than to purge todos deleted a while ago, or --all to empty the trash")
+	}
+	age, err := todoDomain.ParseAge(input.OlderThan)
+	if err != nil {
+		return nil, err
+	}
+	cutoff := time.Now().Add(-age)
+	purgedDto := make([]*PurgeTrashUsecaseOutputDto, 0)
+	purgedIDs := make([]string, 0)
+	if err := uc.journalRepo.Update(func(journal *todoDomain.Journal) error {
+		if err := uc.todoRepo.Modify(func(todos []*todoDomain.Todo) ([]*todoDomain.Todo, error) {
+			kept := make([]*todoDomain.Todo, 0, len(todos))
+			for _, t := range todos {
+				if !t.TrashedBefore(cutoff) {
+					kept = append(kept, t)
+					continue
+				}
+				purgedIDs = append(purgedIDs, t.ID)
+				purgedDto = append(purgedDto, &PurgeTrashUsecaseOutputDto{
+					ID:        t.ID,
+					Title:     t.Title,
+					DeletedAt: t.DeletedAt.Format("2006-01-02 15:04:05"),
+				})
+			}
+			return kept, nil
+		}); err != nil {
+			return err
+		}
+		journal.Forget(purgedIDs...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return purgedDto, nil
+}
@@ -0,0 +1,49 @@
+package gct
+
+import (
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+type RedoTodoUseCase struct {
+	todoRepo    todoDomain.TodoRepository
+	journalRepo todoDomain.JournalRepository
+}
+
+func NewRedoTodoUseCase(
+	todoRepo todoDomain.TodoRepository,
+	journalRepo todoDomain.JournalRepository,
+) *RedoTodoUseCase {
+	return &RedoTodoUseCase{
+		todoRepo:    todoRepo,
+		journalRepo: journalRepo,
+	}
+}
+
+type RedoTodoUsecaseOutputDto struct {
+	Operation string
+	ID        string
+	Title     string
+}
+
+func (uc *RedoTodoUseCase) Run() (*RedoTodoUsecaseOutputDto, error) {
+	var entry *todoDomain.JournalEntry
+	if err := uc.journalRepo.Update(func(journal *todoDomain.Journal) error {
+		var err error
+		if entry, err = journal.PopRedo(); err != nil {
+			return err
+		}
+		if err := applyJournalEntry(uc.todoRepo, entry, false); err != nil {
+			return err
+		}
+		journal.PushUndo(entry)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	subject := journalEntrySubject(entry)
+	return &RedoTodoUsecaseOutputDto{
+		Operation: entry.Operation,
+		ID:        subject.ID,
+		Title:     subject.Title,
+	}, nil
+}
@@ -0,0 +1,13 @@
+package gct
+
+import (
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+func resolveTodo(todoRepo todoDomain.TodoRepository, listOrder todoDomain.SortSpec, ref string) (*todoDomain.Todo, error) {
+	todos, err := todoRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+	return todoDomain.ResolveID(todoDomain.ActiveTodos(todos), listOrder, ref)
+}
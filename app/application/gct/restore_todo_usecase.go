@@ -0,0 +1,82 @@
+package gct
+
+import (
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+type RestoreTodoUseCase struct {
+	todoRepo    todoDomain.TodoRepository
+	journalRepo todoDomain.JournalRepository
+	listOrder   todoDomain.SortSpec
+}
+
+func NewRestoreTodoUseCase(
+	todoRepo todoDomain.TodoRepository,
+	journalRepo todoDomain.JournalRepository,
+	listOrder todoDomain.SortSpec,
+) *RestoreTodoUseCase {
+	return &RestoreTodoUseCase{
+		todoRepo:    todoRepo,
+		journalRepo: journalRepo,
+		listOrder:   listOrder,
+	}
+}
+
+type RestoreTodoUsecaseOutputDto struct {
+	ID        string
+	Title     string
+	Notes     string
+	Done      bool
+	Status    string
+	Priority  string
+	Tags      []string
+	DueDate   string
+	Subtasks  int
+	CreatedAt string
+}
+
+func (uc *RestoreTodoUseCase) Run(id string) (*RestoreTodoUsecaseOutputDto, error) {
+	var todo *todoDomain.Todo
+	var changes []*todoDomain.JournalChange
+	if err := recordJournal(uc.journalRepo, "restore", func() ([]*todoDomain.JournalChange, error) {
+		var restored []*todoDomain.Todo
+		if err := uc.todoRepo.Modify(func(todos []*todoDomain.Todo) ([]*todoDomain.Todo, error) {
+			var err error
+			if todo, err = todoDomain.ResolveID(todoDomain.TrashedTodos(todos), uc.listOrder, id); err != nil {
+				return nil, err
+			}
+			restored = []*todoDomain.Todo{todo}
+			for _, t := range todoDomain.Descendants(todos, todo.ID) {
+				if t.IsTrashed() && t.DeletedAt.Equal(*todo.DeletedAt) {
+					restored = append(restored, t)
+				}
+			}
+			changes = make([]*todoDomain.JournalChange, len(restored))
+			for i, t := range restored {
+				changes[i] = &todoDomain.JournalChange{Before: t.Clone()}
+				t.Restore()
+			}
+			return todos, nil
+		}); err != nil {
+			return nil, err
+		}
+		for i, t := range restored {
+			changes[i].After = t.Clone()
+		}
+		return changes, nil
+	}); err != nil {
+		return nil, err
+	}
+	return &RestoreTodoUsecaseOutputDto{
+		ID:        todo.ID,
+		Title:     todo.Title,
+		Notes:     todo.Notes,
+		Done:      todo.IsDone(),
+		Status:    string(todo.Status),
+		Priority:  string(todo.Priority),
+		Tags:      todo.Tags,
+		DueDate:   formatDueDate(todo.DueDate),
+		Subtasks:  len(changes) - 1,
+		CreatedAt: formatCreatedAt(todo.CreatedAt),
+	}, nil
+}
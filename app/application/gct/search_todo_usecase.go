@@ -0,0 +1,79 @@
+package gct
+
+import (
+	"time"
+
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+type SearchTodoUseCase struct {
+	todoRepo  todoDomain.TodoRepository
+	listOrder todoDomain.SortSpec
+}
+
+func NewSearchTodoUseCase(
+	todoRepo todoDomain.TodoRepository,
+	listOrder todoDomain.SortSpec,
+) *SearchTodoUseCase {
+	return &SearchTodoUseCase{
+		todoRepo:  todoRepo,
+		listOrder: listOrder,
+	}
+}
+
+type SearchTodoUsecaseInputDto struct {
+	Query string
+}
+
+type SearchTodoUsecaseOutputDto struct {
+	Position   int
+	ID         string
+	Title      string
+	Notes      string
+	Done       bool
+	Status     string
+	Priority   string
+	Tags       []string
+	DueDate    string
+	Overdue    bool
+	DueToday   bool
+	Highlights []string
+	CreatedAt  string
+}
+
+func (uc *SearchTodoUseCase) Run(input *SearchTodoUsecaseInputDto) ([]*SearchTodoUsecaseOutputDto, error) {
+	query, err := todoDomain.ParseSearchQuery(input.Query)
+	if err != nil {
+		return nil, err
+	}
+	todos, err := uc.todoRepo.FindAll()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	active := todoDomain.ActiveTodos(todos)
+	positions := uc.listOrder.Positions(active)
+	todoDto := make([]*SearchTodoUsecaseOutputDto, 0)
+	for _, t := range active {
+		if !query.Matches(t) {
+			continue
+		}
+		todoDto = append(todoDto, &SearchTodoUsecaseOutputDto{
+			Position:   positions[t.ID],
+			ID:         t.ID,
+			Title:      t.Title,
+			Notes:      t.Notes,
+			Done:       t.IsDone(),
+			Status:     string(t.Status),
+			Priority:   string(t.Priority),
+			Tags:       t.Tags,
+			DueDate:    formatDueDate(t.DueDate),
+			Overdue:    t.IsOverdue(now),
+			DueToday:   t.IsDueToday(now),
+			Highlights: query.Highlights(),
+			CreatedAt:  formatCreatedAt(t.CreatedAt),
+		})
+	}
+	return todoDto, nil
+}
@@ -5,37 +5,50 @@ import (
 )
 
 type ToggleTodoUseCase struct {
-	todoRepo todoDomain.TodoRepository
+	todoRepo    todoDomain.TodoRepository
+	journalRepo todoDomain.JournalRepository
+	idGenerator todoDomain.IDGenerator
+	listOrder   todoDomain.SortSpec
 }
 
 func NewToggleTodoUseCase(
 	todoRepo todoDomain.TodoRepository,
+	journalRepo todoDomain.JournalRepository,
+	idGenerator todoDomain.IDGenerator,
+	listOrder todoDomain.SortSpec,
 ) *ToggleTodoUseCase {
 	return &ToggleTodoUseCase{
-		todoRepo: todoRepo,
+		todoRepo:    todoRepo,
+		journalRepo: journalRepo,
+		idGenerator: idGenerator,
+		listOrder:   listOrder,
 	}
 }
 
+type ToggleTodoUsecaseInputDto struct {
+	ID    string
+	Force bool
+}
+
 type ToggleTodoUsecaseOutputDto struct {
-	ID        string
-	Title     string
-	Done      bool
-	CreatedAt string
+	ID          string
+	Title       string
+	Notes       string
+	Done        bool
+	Status      string
+	Priority    string
+	Tags        []string
+	DueDate     string
+	Recurrence  string
+	CreatedAt   string
+	NextID      string
+	NextDueDate string
 }
 
-func (uc *ToggleTodoUseCase) Run(id string) (*ToggleTodoUsecaseOutputDto, error) {
-	todo, err := uc.todoRepo.FindByID(id)
+func (uc *ToggleTodoUseCase) Run(input *ToggleTodoUsecaseInputDto) (*ToggleTodoUsecaseOutputDto, error) {
+	output, err := changeTodoStatus(uc.todoRepo, uc.journalRepo, uc.idGenerator, uc.listOrder, input.ID, (*todoDomain.Todo).ToggledStatus, input.Force, "toggle")
 	if err != nil {
 		return nil, err
 	}
-	todo.Done = !todo.Done
-	if err := uc.todoRepo.Update(todo); err != nil {
-		return nil, err
-	}
-	return &ToggleTodoUsecaseOutputDto{
-		ID:        todo.ID,
-		Title:     todo.Title,
-		Done:      todo.Done,
-		CreatedAt: todo.CreatedAt.Format("2006-01-02 15:04:05"),
-	}, nil
+	return (*ToggleTodoUsecaseOutputDto)(output), nil
 }
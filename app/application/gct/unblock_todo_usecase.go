@@ -0,0 +1,74 @@
+package gct
+
+import (
+	"errors"
+	"fmt"
+
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+type UnblockTodoUseCase struct {
+	todoRepo    todoDomain.TodoRepository
+	journalRepo todoDomain.JournalRepository
+	listOrder   todoDomain.SortSpec
+}
+
+func NewUnblockTodoUseCase(
+	todoRepo todoDomain.TodoRepository,
+	journalRepo todoDomain.JournalRepository,
+	listOrder todoDomain.SortSpec,
+) *UnblockTodoUseCase {
+	return &UnblockTodoUseCase{
+		todoRepo:    todoRepo,
+		journalRepo: journalRepo,
+		listOrder:   listOrder,
+	}
+}
+
+type UnblockTodoUsecaseInputDto struct {
+	ID        string
+	BlockerID string
+}
+
+type UnblockTodoUsecaseOutputDto struct {
+	ID        string
+	Title     string
+	BlockedBy []string
+}
+
+func (uc *UnblockTodoUseCase) Run(input *UnblockTodoUsecaseInputDto) (*UnblockTodoUsecaseOutputDto, error) {
+	var todo, before *todoDomain.Todo
+	if err := recordJournal(uc.journalRepo, "unblock", func() ([]*todoDomain.JournalChange, error) {
+		if err := uc.todoRepo.Modify(func(todos []*todoDomain.Todo) ([]*todoDomain.Todo, error) {
+			var err error
+			active := todoDomain.ActiveTodos(todos)
+			if todo, err = todoDomain.ResolveID(active, uc.listOrder, input.ID); err != nil {
+				return nil, err
+			}
+			blockerID := ""
+			if input.BlockerID != "" {
+				blocker, err := todoDomain.ResolveID(active, uc.listOrder, input.BlockerID)
+				if errors.Is(err, todoDomain.ErrTodoNotFound) {
+					// a blocker that has been moved to the trash can still be referred to by its ID
+					blocker, err = todoDomain.ResolveID(todoDomain.TrashedTodos(todos), uc.listOrder, input.BlockerID)
+				}
+				if err != nil {
+					return nil, fmt.Errorf("blocker: %w", err)
+				}
+				blockerID = blocker.ID
+			}
+			before = todo.Clone()
+			return todos, todo.Unblock(blockerID)
+		}); err != nil {
+			return nil, err
+		}
+		return []*todoDomain.JournalChange{{Before: before, After: todo.Clone()}}, nil
+	}); err != nil {
+		return nil, err
+	}
+	return &UnblockTodoUsecaseOutputDto{
+		ID:        todo.ID,
+		Title:     todo.Title,
+		BlockedBy: todo.BlockedBy,
+	}, nil
+}
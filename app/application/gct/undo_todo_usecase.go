@@ -0,0 +1,49 @@
+package gct
+
+import (
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+)
+
+type UndoTodoUseCase struct {
+	todoRepo    todoDomain.TodoRepository
+	journalRepo todoDomain.JournalRepository
+}
+
+func NewUndoTodoUseCase(
+	todoRepo todoDomain.TodoRepository,
+	journalRepo todoDomain.JournalRepository,
+) *UndoTodoUseCase {
+	return &UndoTodoUseCase{
+		todoRepo:    todoRepo,
+		journalRepo: journalRepo,
+	}
+}
+
+type UndoTodoUsecaseOutputDto struct {
+	Operation string
+	ID        string
+	Title     string
+}
+
+func (uc *UndoTodoUseCase) Run() (*UndoTodoUsecaseOutputDto, error) {
+	var entry *todoDomain.JournalEntry
+	if err := uc.journalRepo.Update(func(journal *todoDomain.Journal) error {
+		var err error
+		if entry, err = journal.PopUndo(); err != nil {
+			return err
+		}
+		if err := applyJournalEntry(uc.todoRepo, entry, true); err != nil {
+			return err
+		}
+		journal.PushRedo(entry)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	subject := journalEntrySubject(entry)
+	return &UndoTodoUsecaseOutputDto{
+		Operation: entry.Operation,
+		ID:        subject.ID,
+		Title:     subject.Title,
+	}, nil
+}
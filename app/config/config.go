@@ -1,6 +1,8 @@
 package config
 
 import (
+	"time"
+
 	"github.com/yanosea/gct/pkg/proxy"
 )
 
@@ -21,8 +23,12 @@ func NewConfigurator(
 }
 
 type TodoConfig struct {
-	DBDirPath    string `envconfig:"GCT_DB_DIR_PATH" default:"XDG_DATA_HOME/gct"`
-	OutputFormat string `envconfig:"GCT_OUTPUT_FORMAT" default:"text"`
+	DBDirPath    string        `envconfig:"GCT_DB_DIR_PATH" default:"XDG_DATA_HOME/gct"`
+	DefaultSort  string        `envconfig:"GCT_DEFAULT_SORT" default:""`
+	IDFormat     string        `envconfig:"GCT_ID_FORMAT" default:"short"`
+	LockTimeout  time.Duration `envconfig:"GCT_LOCK_TIMEOUT" default:"5s"`
+	OutputFormat string        `envconfig:"GCT_OUTPUT_FORMAT" default:"text"`
+	TodoTxtFile  string        `envconfig:"GCT_TODO_TXT_FILE" default:""`
 }
 
 func (c *configurator) GetConfig() (*TodoConfig, error) {
@@ -0,0 +1,50 @@
+package todo
+
+import (
+	"time"
+)
+
+const (
+	ArchivePeriodLayout = "2006-01"
+)
+
+func (t *Todo) CompletedBefore(cutoff time.Time) bool {
+	return t.IsClosed() && !t.IsTrashed() && !t.completionTime().After(cutoff)
+}
+
+func (t *Todo) ArchivePeriod() string {
+	return t.completionTime().Format(ArchivePeriodLayout)
+}
+
+func (t *Todo) completionTime() time.Time {
+	if t.CompletedAt != nil {
+		return *t.CompletedAt
+	}
+	return t.CreatedAt
+}
+
+func ArchivableTodos(todos []*Todo, cutoff time.Time) []*Todo {
+	archivable := make(map[string]bool)
+	for _, t := range todos {
+		if t.CompletedBefore(cutoff) {
+			archivable[t.ID] = true
+		}
+	}
+	// keep a parent while any of its subtasks stays behind, counting ones in the trash so they can still be restored under it
+	for changed := true; changed; {
+		changed = false
+		for _, t := range todos {
+			if t.ParentID != "" && archivable[t.ParentID] && !archivable[t.ID] {
+				delete(archivable, t.ParentID)
+				changed = true
+			}
+		}
+	}
+	result := make([]*Todo, 0, len(archivable))
+	for _, t := range todos {
+		if archivable[t.ID] {
+			result = append(result, t)
+		}
+	}
+	return result
+}
@@ -0,0 +1,6 @@
+package todo
+
+type ArchiveRepository interface {
+	Append(period string, todos []*Todo) error
+	FindAll() ([]*Todo, error)
+}
@@ -0,0 +1,82 @@
+package todo
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestArchivableTodos(t *testing.T) {
+	cutoff := time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)
+	completed := time.Date(2026, 9, 15, 0, 0, 0, 0, time.Local)
+	recent := time.Date(2026, 10, 10, 0, 0, 0, 0, time.Local)
+	todo := func(id string, parentID string, status Status, completedAt *time.Time, trashed bool) *Todo {
+		t := &Todo{ID: id, ParentID: parentID, Status: status, CompletedAt: completedAt, CreatedAt: completed}
+		if trashed {
+			t.DeletedAt = &recent
+		}
+		return t
+	}
+
+	tests := []struct {
+		name  string
+		todos []*Todo
+		want  []string
+	}{
+		{
+			name: "completed before the cutoff",
+			todos: []*Todo{
+				todo("a", "", StatusDone, &completed, false),
+				todo("b", "", StatusCancelled, &completed, false),
+				todo("c", "", StatusDone, &recent, false),
+				todo("d", "", StatusTodo, nil, false),
+			},
+			want: []string{"a", "b"},
+		},
+		{
+			name: "trashed todos stay in the trash",
+			todos: []*Todo{
+				todo("a", "", StatusDone, &completed, true),
+			},
+			want: []string{},
+		},
+		{
+			name: "parent and subtasks go together",
+			todos: []*Todo{
+				todo("p", "", StatusDone, &completed, false),
+				todo("c", "p", StatusDone, &completed, false),
+				todo("g", "c", StatusDone, &completed, false),
+			},
+			want: []string{"p", "c", "g"},
+		},
+		{
+			name: "open subtask keeps its ancestors",
+			todos: []*Todo{
+				todo("p", "", StatusDone, &completed, false),
+				todo("c", "p", StatusDone, &completed, false),
+				todo("g", "c", StatusTodo, nil, false),
+				todo("s", "p", StatusDone, &completed, false),
+			},
+			want: []string{"s"},
+		},
+		{
+			name: "trashed subtask keeps its parent",
+			todos: []*Todo{
+				todo("p", "", StatusDone, &completed, false),
+				todo("c", "p", StatusDone, &completed, true),
+			},
+			want: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := make([]string, 0)
+			for _, todo := range ArchivableTodos(tt.todos, cutoff) {
+				got = append(got, todo.ID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ArchivableTodos = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
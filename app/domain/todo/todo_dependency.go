@@ -0,0 +1,95 @@
+package todo
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrDependencyCycle = errors.New("dependency cycle")
+)
+
+type BlockedError struct {
+	Blockers []*Todo
+}
+
+func (e *BlockedError) Error() string {
+	titles := make([]string, len(e.Blockers))
+	for i, blocker := range e.Blockers {
+		titles[i] = fmt.Sprintf("%q (%s)", blocker.Title, blocker.ID)
+	}
+	return "todo is blocked by open todos: " + strings.Join(titles, ", ")
+}
+
+func (t *Todo) IsBlockedBy(id string) bool {
+	return slices.Contains(t.BlockedBy, id)
+}
+
+func (t *Todo) BlockOn(blocker *Todo, todos []*Todo) error {
+	if blocker.ID == t.ID {
+		return errors.New("a todo cannot block itself")
+	}
+	if t.IsBlockedBy(blocker.ID) {
+		return fmt.Errorf("todo is already blocked by %q", blocker.Title)
+	}
+	if path := dependencyPath(todos, blocker.ID, t.ID); path != nil {
+		return fmt.Errorf("%w: %s", ErrDependencyCycle, strings.Join(append([]string{t.ID}, path...), " -> "))
+	}
+	t.BlockedBy = append(t.BlockedBy, blocker.ID)
+	return nil
+}
+
+func (t *Todo) Unblock(blockerID string) error {
+	if blockerID == "" {
+		t.BlockedBy = nil
+		return nil
+	}
+	index := slices.Index(t.BlockedBy, blockerID)
+	if index < 0 {
+		return errors.New("todo is not blocked by that todo")
+	}
+	t.BlockedBy = slices.Delete(t.BlockedBy, index, index+1)
+	if len(t.BlockedBy) == 0 {
+		t.BlockedBy = nil
+	}
+	return nil
+}
+
+func OpenBlockers(t *Todo, todos []*Todo) []*Todo {
+	blockers := make([]*Todo, 0)
+	for _, other := range todos {
+		if t.IsBlockedBy(other.ID) && !other.IsClosed() && !other.IsTrashed() {
+			blockers = append(blockers, other)
+		}
+	}
+	return blockers
+}
+
+func dependencyPath(todos []*Todo, fromID string, toID string) []string {
+	byID := make(map[string]*Todo, len(todos))
+	for _, t := range todos {
+		byID[t.ID] = t
+	}
+	visited := make(map[string]bool)
+	var walk func(id string) []string
+	walk = func(id string) []string {
+		if id == toID {
+			return []string{id}
+		}
+		if visited[id] {
+			return nil
+		}
+		visited[id] = true
+		if t, ok := byID[id]; ok {
+			for _, next := range t.BlockedBy {
+				if path := walk(next); path != nil {
+					return append([]string{id}, path...)
+				}
+			}
+		}
+		return nil
+	}
+	return walk(fromID)
+}
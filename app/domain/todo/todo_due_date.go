@@ -0,0 +1,34 @@
+package todo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	DueDateLayout = "2006-01-02"
+)
+
+func ParseDueDate(value string, now time.Time) (*time.Time, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "none":
+		return nil, nil
+	case "today":
+		dueDate := startOfDay(now)
+		return &dueDate, nil
+	case "tomorrow":
+		dueDate := startOfDay(now).AddDate(0, 0, 1)
+		return &dueDate, nil
+	}
+	dueDate, err := time.ParseInLocation(DueDateLayout, strings.TrimSpace(value), now.Location())
+	if err != nil {
+		return nil, fmt.Errorf("invalid due date %q (expected YYYY-MM-DD, today, tomorrow or none)", value)
+	}
+	return &dueDate, nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,111 @@
+package todo
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	shortIDAlphabet = "0123456789abcdefghjkmnpqrstvwxyz"
+	shortIDLength   = 8
+)
+
+var ErrTodoNotFound = errors.New("todo not found")
+
+type IDGenerator interface {
+	Generate(now time.Time) string
+}
+
+func NewIDGenerator(format string) (IDGenerator, error) {
+	switch format {
+	case "short":
+		return NewShortIDGenerator(), nil
+	case "unixnano":
+		return NewUnixNanoIDGenerator(), nil
+	default:
+		return nil, fmt.Errorf("invalid id format %q (expected short|unixnano)", format)
+	}
+}
+
+type shortIDGenerator struct{}
+
+func NewShortIDGenerator() IDGenerator {
+	return &shortIDGenerator{}
+}
+
+func (g *shortIDGenerator) Generate(now time.Time) string {
+	buf := make([]byte, shortIDLength)
+	if _, err := rand.Read(buf); err != nil {
+		return NewUnixNanoIDGenerator().Generate(now)
+	}
+	id := make([]byte, shortIDLength)
+	for i, b := range buf {
+		alphabet := shortIDAlphabet
+		if i == 0 {
+			// a leading letter keeps IDs from being mistaken for list positions
+			alphabet = strings.TrimLeft(shortIDAlphabet, "0123456789")
+		}
+		id[i] = alphabet[int(b)%len(alphabet)]
+	}
+	return string(id)
+}
+
+type unixNanoIDGenerator struct{}
+
+func NewUnixNanoIDGenerator() IDGenerator {
+	return &unixNanoIDGenerator{}
+}
+
+func (g *unixNanoIDGenerator) Generate(now time.Time) string {
+	return fmt.Sprintf("%d", now.UnixNano())
+}
+
+type AmbiguousIDError struct {
+	Ref     string
+	Matches []string
+}
+
+func (e *AmbiguousIDError) Error() string {
+	return fmt.Sprintf("id %q is ambiguous, it matches %d todos: %s", e.Ref, len(e.Matches), strings.Join(e.Matches, ", "))
+}
+
+func ResolveID(todos []*Todo, order SortSpec, ref string) (*Todo, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return nil, errors.New("id is empty")
+	}
+	for _, todo := range todos {
+		if todo.ID == ref {
+			return todo, nil
+		}
+	}
+	// a number within the list length is the position printed by the list, which follows the given
+	// list order rather than the file order; numeric IDs are still reachable in full or by a longer prefix
+	if strings.Trim(ref, "0123456789") == "" {
+		if position, err := strconv.Atoi(ref); err == nil && position >= 1 && position <= len(todos) {
+			return order.Sorted(todos)[position-1], nil
+		}
+	}
+	var matches []*Todo
+	for _, todo := range todos {
+		if strings.HasPrefix(todo.ID, ref) {
+			matches = append(matches, todo)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, ErrTodoNotFound
+	case 1:
+		return matches[0], nil
+	default:
+		ids := make([]string, len(matches))
+		for i, match := range matches {
+			ids[i] = match.ID
+		}
+		return nil, &AmbiguousIDError{Ref: ref, Matches: ids}
+	}
+}
@@ -0,0 +1,88 @@
+package todo
+
+import (
+	"errors"
+	"maps"
+	"testing"
+)
+
+func TestResolveID(t *testing.T) {
+	todos := []*Todo{
+		{ID: "1718000000123456789", Title: "legacy"},
+		{ID: "1718000000987654321", Title: "legacy too"},
+		{ID: "k4bt3shg", Title: "short"},
+		{ID: "k4bx9qrm", Title: "short too"},
+		{ID: "t3e498ea", Title: "derived"},
+		{ID: "2", Title: "numeric id"},
+	}
+
+	tests := []struct {
+		name      string
+		ref       string
+		want      string
+		wantErr   error
+		ambiguous bool
+	}{
+		{name: "exact id", ref: "k4bt3shg", want: "k4bt3shg"},
+		{name: "exact id wins over a position", ref: "2", want: "2"},
+		{name: "position", ref: "1", want: "1718000000123456789"},
+		{name: "position of the last todo", ref: "6", want: "2"},
+		{name: "position with surrounding space", ref: " 3 ", want: "k4bt3shg"},
+		{name: "number past the list is a prefix", ref: "17180000001", want: "1718000000123456789"},
+		{name: "unique prefix", ref: "k4bx", want: "k4bx9qrm"},
+		{name: "derived todo.txt id prefix", ref: "t3", want: "t3e498ea"},
+		{name: "ambiguous prefix", ref: "k4b", ambiguous: true},
+		{name: "ambiguous numeric prefix", ref: "1718", ambiguous: true},
+		{name: "signed number is not a position", ref: "+1", wantErr: ErrTodoNotFound},
+		{name: "zero is not a position", ref: "0", wantErr: ErrTodoNotFound},
+		{name: "unknown", ref: "zzz", wantErr: ErrTodoNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveID(todos, nil, tt.ref)
+			var ambiguous *AmbiguousIDError
+			switch {
+			case tt.ambiguous:
+				if !errors.As(err, &ambiguous) {
+					t.Fatalf("ResolveID(%q) error = %v, want an ambiguous id error", tt.ref, err)
+				}
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ResolveID(%q) error = %v, want %v", tt.ref, err, tt.wantErr)
+				}
+			case err != nil:
+				t.Fatalf("ResolveID(%q) returned error: %v", tt.ref, err)
+			case got.ID != tt.want:
+				t.Errorf("ResolveID(%q) = %q, want %q", tt.ref, got.ID, tt.want)
+			}
+		})
+	}
+
+	if _, err := ResolveID(todos, nil, " "); err == nil {
+		t.Error("ResolveID with an empty reference returned no error")
+	}
+}
+
+func TestResolveIDFollowsListOrder(t *testing.T) {
+	todos := []*Todo{
+		{ID: "k4bt3shg", Title: "write report"},
+		{ID: "m9cq2zxd", Title: "buy milk"},
+		{ID: "p7dr5wnv", Title: "call mom"},
+	}
+	order, err := ParseSortSpec("title")
+	if err != nil {
+		t.Fatalf("ParseSortSpec returned error: %v", err)
+	}
+	for ref, want := range map[string]string{"1": "m9cq2zxd", "2": "p7dr5wnv", "3": "k4bt3shg"} {
+		got, err := ResolveID(todos, order, ref)
+		if err != nil {
+			t.Fatalf("ResolveID(%q) returned error: %v", ref, err)
+		}
+		if got.ID != want {
+			t.Errorf("ResolveID(%q) = %q, want %q", ref, got.ID, want)
+		}
+	}
+	if got, want := order.Positions(todos), map[string]int{"m9cq2zxd": 1, "p7dr5wnv": 2, "k4bt3shg": 3}; !maps.Equal(got, want) {
+		t.Errorf("Positions = %v, want %v", got, want)
+	}
+}
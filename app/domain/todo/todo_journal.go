@@ -0,0 +1,103 @@
+package todo
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	maxJournalEntries = 100
+)
+
+var (
+	ErrNothingToUndo = errors.New("nothing to undo")
+	ErrNothingToRedo = errors.New("nothing to redo")
+)
+
+type JournalChange struct {
+	Before *Todo `json:"before,omitempty"`
+	After  *Todo `json:"after,omitempty"`
+}
+
+type JournalEntry struct {
+	Operation  string           `json:"operation"`
+	Changes    []*JournalChange `json:"changes"`
+	RecordedAt time.Time        `json:"recorded_at"`
+}
+
+type Journal struct {
+	Undo []*JournalEntry `json:"undo"`
+	Redo []*JournalEntry `json:"redo"`
+}
+
+func NewJournalEntry(operation string, changes ...*JournalChange) *JournalEntry {
+	return &JournalEntry{
+		Operation:  operation,
+		Changes:    changes,
+		RecordedAt: time.Now(),
+	}
+}
+
+func (j *Journal) Record(entry *JournalEntry) {
+	j.PushUndo(entry)
+	j.Redo = nil
+}
+
+func (j *Journal) PushUndo(entry *JournalEntry) {
+	j.Undo = append(j.Undo, entry)
+	if len(j.Undo) > maxJournalEntries {
+		j.Undo = j.Undo[len(j.Undo)-maxJournalEntries:]
+	}
+}
+
+func (j *Journal) PushRedo(entry *JournalEntry) {
+	j.Redo = append(j.Redo, entry)
+}
+
+func (j *Journal) PopUndo() (*JournalEntry, error) {
+	if len(j.Undo) == 0 {
+		return nil, ErrNothingToUndo
+	}
+	entry := j.Undo[len(j.Undo)-1]
+	j.Undo = j.Undo[:len(j.Undo)-1]
+	return entry, nil
+}
+
+func (j *Journal) PopRedo() (*JournalEntry, error) {
+	if len(j.Redo) == 0 {
+		return nil, ErrNothingToRedo
+	}
+	entry := j.Redo[len(j.Redo)-1]
+	j.Redo = j.Redo[:len(j.Redo)-1]
+	return entry, nil
+}
+
+func (j *Journal) Forget(ids ...string) {
+	forget := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		forget[id] = true
+	}
+	keep := func(entries []*JournalEntry) []*JournalEntry {
+		kept := entries[:0]
+		for _, entry := range entries {
+			if !entry.touches(forget) {
+				kept = append(kept, entry)
+			}
+		}
+		return kept
+	}
+	j.Undo = keep(j.Undo)
+	j.Redo = keep(j.Redo)
+}
+
+func (e *JournalEntry) touches(ids map[string]bool) bool {
+	for _, change := range e.Changes {
+		if change.Before != nil && ids[change.Before.ID] {
+			return true
+		}
+		if change.After != nil && ids[change.After.ID] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,6 @@
+package todo
+
+type JournalRepository interface {
+	Load() (*Journal, error)
+	Update(fn func(journal *Journal) error) error
+}
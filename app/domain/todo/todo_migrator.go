@@ -0,0 +1,19 @@
+package todo
+
+type MigrationStep struct {
+	Version     int
+	Description string
+}
+
+type MigrationPlan struct {
+	CurrentVersion int
+	TargetVersion  int
+	Steps          []*MigrationStep
+	TodoCount      int
+	BackupFilePath string
+}
+
+type TodoMigrator interface {
+	Plan() (*MigrationPlan, error)
+	Migrate() (*MigrationPlan, error)
+}
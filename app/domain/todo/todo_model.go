@@ -2,30 +2,118 @@ package todo
 
 import (
 	"errors"
-	"fmt"
+	"maps"
+	"slices"
+	"strings"
 	"time"
 )
 
 type Todo struct {
-	ID        string    `json:"id"`
-	Title     string    `json:"title"`
-	Done      bool      `json:"done"`
-	CreatedAt time.Time `json:"created_at"`
+	ID          string            `json:"id"`
+	Title       string            `json:"title"`
+	Notes       string            `json:"notes,omitempty"`
+	Status      Status            `json:"status"`
+	Priority    Priority          `json:"priority,omitempty"`
+	Tags        []string          `json:"tags,omitempty"`
+	ParentID    string            `json:"parent_id,omitempty"`
+	BlockedBy   []string          `json:"blocked_by,omitempty"`
+	Recurrence  Recurrence        `json:"recurrence,omitempty"`
+	DueDate     *time.Time        `json:"due_date,omitempty"`
+	CreatedAt   time.Time         `json:"created_at"`
+	CompletedAt *time.Time        `json:"completed_at,omitempty"`
+	DeletedAt   *time.Time        `json:"deleted_at,omitempty"`
+	Extras      map[string]string `json:"extras,omitempty"`
 }
 
-func NewTodo(title string) (*Todo, error) {
-	if title == "" {
-		return nil, errors.New("title is empty")
+func NewTodo(idGenerator IDGenerator, title string) (*Todo, error) {
+	if err := validateTitle(title); err != nil {
+		return nil, err
 	}
 	now := time.Now()
 	return &Todo{
-		ID:        generateUUID(now),
+		ID:        idGenerator.Generate(now),
 		Title:     title,
-		Done:      false,
+		Status:    StatusTodo,
 		CreatedAt: now,
 	}, nil
 }
 
-func generateUUID(now time.Time) string {
-	return fmt.Sprintf("%d", now.UnixNano())
+func (t *Todo) Clone() *Todo {
+	clone := *t
+	if t.Tags != nil {
+		clone.Tags = append([]string{}, t.Tags...)
+	}
+	if t.BlockedBy != nil {
+		clone.BlockedBy = append([]string{}, t.BlockedBy...)
+	}
+	if t.DueDate != nil {
+		dueDate := *t.DueDate
+		clone.DueDate = &dueDate
+	}
+	if t.CompletedAt != nil {
+		completedAt := *t.CompletedAt
+		clone.CompletedAt = &completedAt
+	}
+	if t.DeletedAt != nil {
+		deletedAt := *t.DeletedAt
+		clone.DeletedAt = &deletedAt
+	}
+	if t.Extras != nil {
+		clone.Extras = maps.Clone(t.Extras)
+	}
+	return &clone
+}
+
+// Equal compares times as instants, so a todo equals itself after a round trip through the file it is stored in.
+func (t *Todo) Equal(other *Todo) bool {
+	return t.ID == other.ID &&
+		t.Title == other.Title &&
+		t.Notes == other.Notes &&
+		t.Status == other.Status &&
+		t.Priority == other.Priority &&
+		slices.Equal(t.Tags, other.Tags) &&
+		t.ParentID == other.ParentID &&
+		slices.Equal(t.BlockedBy, other.BlockedBy) &&
+		t.Recurrence == other.Recurrence &&
+		equalTimes(t.DueDate, other.DueDate) &&
+		t.CreatedAt.Equal(other.CreatedAt) &&
+		equalTimes(t.CompletedAt, other.CompletedAt) &&
+		equalTimes(t.DeletedAt, other.DeletedAt) &&
+		maps.Equal(t.Extras, other.Extras)
+}
+
+func (t *Todo) SetTitle(title string) error {
+	if err := validateTitle(title); err != nil {
+		return err
+	}
+	t.Title = title
+	return nil
+}
+
+func (t *Todo) IsOverdue(now time.Time) bool {
+	if t.IsClosed() || t.DueDate == nil {
+		return false
+	}
+	return t.DueDate.Before(startOfDay(now))
+}
+
+func (t *Todo) IsDueToday(now time.Time) bool {
+	if t.IsClosed() || t.DueDate == nil {
+		return false
+	}
+	return startOfDay(t.DueDate.In(now.Location())).Equal(startOfDay(now))
+}
+
+func equalTimes(a *time.Time, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+func validateTitle(title string) error {
+	if strings.TrimSpace(title) == "" {
+		return errors.New("title is empty")
+	}
+	return nil
 }
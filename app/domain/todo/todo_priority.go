@@ -0,0 +1,43 @@
+package todo
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Priority string
+
+const (
+	PriorityNone   Priority = ""
+	PriorityLow    Priority = "low"
+	PriorityMedium Priority = "medium"
+	PriorityHigh   Priority = "high"
+)
+
+func ParsePriority(value string) (Priority, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "", "none", "0":
+		return PriorityNone, nil
+	case "low", "l", "3":
+		return PriorityLow, nil
+	case "medium", "m", "2":
+		return PriorityMedium, nil
+	case "high", "h", "1":
+		return PriorityHigh, nil
+	default:
+		return PriorityNone, fmt.Errorf("invalid priority %q (expected high|medium|low|none or 1-3)", value)
+	}
+}
+
+func (p Priority) Rank() int {
+	switch p {
+	case PriorityHigh:
+		return 3
+	case PriorityMedium:
+		return 2
+	case PriorityLow:
+		return 1
+	default:
+		return 0
+	}
+}
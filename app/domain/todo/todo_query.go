@@ -0,0 +1,234 @@
+package todo
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+type Query struct {
+	clauses    []*queryClause
+	highlights []string
+}
+
+type queryClause struct {
+	negated bool
+	match   func(t *Todo) bool
+}
+
+var (
+	queryFields    = []string{"title", "notes", "status", "done", "priority", "tag", "due", "created", "completed"}
+	queryOperators = []string{"!=", ">=", "<=", ":", "=", "~", ">", "<"}
+)
+
+func ParseQuery(query string, now time.Time) (*Query, error) {
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	q := &Query{}
+	for _, token := range tokens {
+		field, operator, value := splitQueryToken(token)
+		if field == "" {
+			text := strings.ToLower(token.text)
+			q.add(token.negated, func(t *Todo) bool {
+				return t.containsText(text)
+			})
+			if !token.negated {
+				q.highlights = append(q.highlights, text)
+			}
+			continue
+		}
+		match, err := parseQueryClause(field, operator, value, now)
+		if err != nil {
+			return nil, err
+		}
+		q.add(token.negated, match)
+		if !token.negated && operator == "~" && (field == "title" || field == "notes") {
+			q.highlights = append(q.highlights, strings.ToLower(value))
+		}
+	}
+	return q, nil
+}
+
+func (q *Query) Matches(t *Todo) bool {
+	for _, clause := range q.clauses {
+		if clause.match(t) == clause.negated {
+			return false
+		}
+	}
+	return true
+}
+
+func (q *Query) Highlights() []string {
+	return q.highlights
+}
+
+func (q *Query) add(negated bool, match func(t *Todo) bool) {
+	q.clauses = append(q.clauses, &queryClause{negated: negated, match: match})
+}
+
+func splitQueryToken(token *queryToken) (string, string, string) {
+	prefix := token.text[:token.literal]
+	index := strings.IndexAny(prefix, "!=<>:~")
+	if index <= 0 {
+		return "", "", ""
+	}
+	// anything else before an operator, like the scheme of a URL, is plain text
+	field := strings.ToLower(prefix[:index])
+	if !slices.Contains(queryFields, field) {
+		return "", "", ""
+	}
+	for _, operator := range queryOperators {
+		if strings.HasPrefix(token.text[index:], operator) {
+			return field, operator, token.text[index+len(operator):]
+		}
+	}
+	return "", "", ""
+}
+
+func parseQueryClause(field string, operator string, value string, now time.Time) (func(t *Todo) bool, error) {
+	unsupported := fmt.Errorf("operator %q is not supported for query field %q", operator, field)
+	switch field {
+	case "title", "notes":
+		value = strings.ToLower(value)
+		text := func(t *Todo) string {
+			if field == "title" {
+				return strings.ToLower(t.Title)
+			}
+			return strings.ToLower(t.Notes)
+		}
+		switch operator {
+		case "~":
+			return func(t *Todo) bool { return strings.Contains(text(t), value) }, nil
+		case ":", "=":
+			return func(t *Todo) bool { return text(t) == value }, nil
+		case "!=":
+			return func(t *Todo) bool { return text(t) != value }, nil
+		}
+		return nil, unsupported
+	case "status":
+		status, err := ParseStatus(value)
+		if err != nil {
+			return nil, err
+		}
+		switch operator {
+		case ":", "=":
+			return func(t *Todo) bool { return t.Status == status }, nil
+		case "!=":
+			return func(t *Todo) bool { return t.Status != status }, nil
+		}
+		return nil, unsupported
+	case "done":
+		var done bool
+		switch strings.ToLower(value) {
+		case "true", "yes":
+			done = true
+		case "false", "no":
+			done = false
+		default:
+			return nil, fmt.Errorf("invalid value %q for query field %q (expected true or false)", value, field)
+		}
+		switch operator {
+		case ":", "=":
+			return func(t *Todo) bool { return t.IsDone() == done }, nil
+		case "!=":
+			return func(t *Todo) bool { return t.IsDone() != done }, nil
+		}
+		return nil, unsupported
+	case "priority":
+		priority, err := ParsePriority(value)
+		if err != nil {
+			return nil, err
+		}
+		compare, ok := queryComparison(operator)
+		if !ok {
+			return nil, unsupported
+		}
+		return func(t *Todo) bool { return compare(t.Priority.Rank() - priority.Rank()) }, nil
+	case "tag":
+		tag, err := NormalizeTag(value)
+		if err != nil {
+			return nil, err
+		}
+		switch operator {
+		case ":", "=":
+			return func(t *Todo) bool { return t.HasTag(tag) }, nil
+		case "!=":
+			return func(t *Todo) bool { return !t.HasTag(tag) }, nil
+		}
+		return nil, unsupported
+	case "due", "created", "completed":
+		date := func(t *Todo) *time.Time {
+			switch field {
+			case "due":
+				return t.DueDate
+			case "created":
+				if t.CreatedAt.IsZero() {
+					return nil
+				}
+				return &t.CreatedAt
+			default:
+				return t.CompletedAt
+			}
+		}
+		day, err := parseQueryDate(field, value, now)
+		if err != nil {
+			return nil, err
+		}
+		compare, ok := queryComparison(operator)
+		if !ok {
+			return nil, unsupported
+		}
+		if day == nil {
+			switch operator {
+			case ":", "=":
+				return func(t *Todo) bool { return date(t) == nil }, nil
+			case "!=":
+				return func(t *Todo) bool { return date(t) != nil }, nil
+			}
+			return nil, fmt.Errorf("query field %q cannot be compared with %q", field, value)
+		}
+		return func(t *Todo) bool {
+			d := date(t)
+			if d == nil {
+				return operator == "!="
+			}
+			return compare(startOfDay(d.In(now.Location())).Compare(*day))
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown query field %q (expected %s)", field, strings.Join(queryFields, ", "))
+	}
+}
+
+func parseQueryDate(field string, value string, now time.Time) (*time.Time, error) {
+	if strings.ToLower(value) == "yesterday" {
+		day := startOfDay(now).AddDate(0, 0, -1)
+		return &day, nil
+	}
+	day, err := ParseDueDate(value, now)
+	if err != nil {
+		return nil, fmt.Errorf("invalid date %q for query field %q (expected YYYY-MM-DD, yesterday, today, tomorrow or none)", value, field)
+	}
+	return day, nil
+}
+
+func queryComparison(operator string) (func(diff int) bool, bool) {
+	switch operator {
+	case ":", "=":
+		return func(diff int) bool { return diff == 0 }, true
+	case "!=":
+		return func(diff int) bool { return diff != 0 }, true
+	case ">":
+		return func(diff int) bool { return diff > 0 }, true
+	case ">=":
+		return func(diff int) bool { return diff >= 0 }, true
+	case "<":
+		return func(diff int) bool { return diff < 0 }, true
+	case "<=":
+		return func(diff int) bool { return diff <= 0 }, true
+	default:
+		return nil, false
+	}
+}
@@ -0,0 +1,105 @@
+package todo
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParseQuery(t *testing.T) {
+	now := time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC)
+	date := func(day int) *time.Time {
+		d := time.Date(2026, 10, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+	todos := []*Todo{
+		{ID: "deploy", Title: "Deploy the API", Status: StatusInProgress, Priority: PriorityHigh, Tags: []string{"work"}, DueDate: date(15), CreatedAt: *date(1)},
+		{ID: "milk", Title: "Buy milk", Notes: "oat milk, see http://shop.example", Status: StatusTodo, Priority: PriorityLow, Tags: []string{"home"}, DueDate: date(16), CreatedAt: *date(10)},
+		{ID: "report", Title: "Write report later", Status: StatusDone, Priority: PriorityMedium, Tags: []string{"work"}, CreatedAt: *date(5), CompletedAt: date(14)},
+		{ID: "call", Title: "Call mom", Status: StatusCancelled, CreatedAt: *date(12), CompletedAt: date(13)},
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{name: "empty query matches everything", query: "", want: []string{"deploy", "milk", "report", "call"}},
+		{name: "free text searches title and notes", query: "milk", want: []string{"milk"}},
+		{name: "free text is case insensitive", query: "API", want: []string{"deploy"}},
+		{name: "negated free text", query: "-milk", want: []string{"deploy", "report", "call"}},
+		{name: "quoted phrase", query: `"write report"`, want: []string{"report"}},
+		{name: "negated phrase", query: `-"later"`, want: []string{"deploy", "milk", "call"}},
+		{name: "unknown field is free text", query: "http://shop.example", want: []string{"milk"}},
+		{name: "word with a colon is free text", query: "see:nothing", want: []string{}},
+		{name: "title contains", query: `title~"deploy"`, want: []string{"deploy"}},
+		{name: "title equals", query: `title:"buy milk"`, want: []string{"milk"}},
+		{name: "notes contains", query: "notes~oat", want: []string{"milk"}},
+		{name: "status", query: "status:in_progress", want: []string{"deploy"}},
+		{name: "status not equal", query: "status!=todo", want: []string{"deploy", "report", "call"}},
+		{name: "done", query: "done:true", want: []string{"report"}},
+		{name: "not done", query: "done:false", want: []string{"deploy", "milk", "call"}},
+		{name: "priority at least", query: "priority>=medium", want: []string{"deploy", "report"}},
+		{name: "priority below", query: "priority<high", want: []string{"milk", "report", "call"}},
+		{name: "tag", query: "tag:work", want: []string{"deploy", "report"}},
+		{name: "negated tag", query: "-tag:work", want: []string{"milk", "call"}},
+		{name: "due today", query: "due:today", want: []string{"deploy"}},
+		{name: "due on or before tomorrow", query: "due<=tomorrow", want: []string{"deploy", "milk"}},
+		{name: "no due date", query: "due:none", want: []string{"report", "call"}},
+		{name: "has a due date", query: "due!=none", want: []string{"deploy", "milk"}},
+		{name: "created on or after a date", query: "created>=2026-10-05", want: []string{"milk", "report", "call"}},
+		{name: "completed yesterday", query: "completed:yesterday", want: []string{"report"}},
+		{name: "clauses are combined", query: "tag:work done:false", want: []string{"deploy"}},
+		{name: "field names are case insensitive", query: "TAG:home", want: []string{"milk"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := ParseQuery(tt.query, now)
+			if err != nil {
+				t.Fatalf("ParseQuery(%q) returned error: %v", tt.query, err)
+			}
+			got := make([]string, 0)
+			for _, todo := range todos {
+				if query.Matches(todo) {
+					got = append(got, todo.ID)
+				}
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("ParseQuery(%q) matched %v, want %v", tt.query, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseQueryErrors(t *testing.T) {
+	now := time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{name: "unterminated quote", query: `title~"deploy`},
+		{name: "invalid status", query: "status:later"},
+		{name: "invalid done value", query: "done:maybe"},
+		{name: "invalid priority", query: "priority:urgent"},
+		{name: "invalid date", query: "due:someday"},
+		{name: "unsupported operator", query: "tag>work"},
+		{name: "none compared by order", query: "due<none"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseQuery(tt.query, now); err == nil {
+				t.Errorf("ParseQuery(%q) returned no error", tt.query)
+			}
+		})
+	}
+}
+
+func TestQueryHighlights(t *testing.T) {
+	query, err := ParseQuery(`deploy -later title~API tag:work`, time.Now())
+	if err != nil {
+		t.Fatalf("ParseQuery returned error: %v", err)
+	}
+	if got, want := query.Highlights(), []string{"deploy", "api"}; !slices.Equal(got, want) {
+		t.Errorf("Highlights() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,144 @@
+package todo
+
+import (
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Recurrence string
+
+const (
+	RecurrenceNone    Recurrence = ""
+	RecurrenceDaily   Recurrence = "daily"
+	RecurrenceWeekly  Recurrence = "weekly"
+	RecurrenceMonthly Recurrence = "monthly"
+)
+
+var weekdays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+func ParseRecurrence(value string) (Recurrence, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	invalid := fmt.Errorf("invalid recurrence %q (expected daily, weekly, weekly:mon,fri, monthly, monthly:D, every:N or none)", value)
+	switch value {
+	case "", "none":
+		return RecurrenceNone, nil
+	case "daily", "weekly", "monthly":
+		return Recurrence(value), nil
+	}
+	if days, ok := strings.CutPrefix(value, "weekly:"); ok {
+		selected := make(map[int]bool)
+		for _, day := range strings.Split(days, ",") {
+			index := weekdayIndex(strings.TrimSpace(day))
+			if index < 0 {
+				return RecurrenceNone, invalid
+			}
+			selected[index] = true
+		}
+		names := make([]string, 0, len(selected))
+		for i, name := range weekdays {
+			if selected[i] {
+				names = append(names, name)
+			}
+		}
+		return Recurrence("weekly:" + strings.Join(names, ",")), nil
+	}
+	if day, ok := strings.CutPrefix(value, "monthly:"); ok {
+		n, err := strconv.Atoi(day)
+		if err != nil || n < 1 || n > 31 {
+			return RecurrenceNone, invalid
+		}
+		return Recurrence(fmt.Sprintf("monthly:%d", n)), nil
+	}
+	if interval, ok := strings.CutPrefix(value, "every:"); ok {
+		n, err := strconv.Atoi(strings.TrimSuffix(interval, "d"))
+		if err != nil || n < 1 {
+			return RecurrenceNone, invalid
+		}
+		return Recurrence(fmt.Sprintf("every:%d", n)), nil
+	}
+	return RecurrenceNone, invalid
+}
+
+func (r Recurrence) Next(from time.Time) time.Time {
+	from = startOfDay(from)
+	switch {
+	case r == RecurrenceDaily:
+		return from.AddDate(0, 0, 1)
+	case r == RecurrenceWeekly:
+		return from.AddDate(0, 0, 7)
+	case r == RecurrenceMonthly:
+		return addMonthClamped(from, from.Day())
+	case strings.HasPrefix(string(r), "monthly:"):
+		day, _ := strconv.Atoi(strings.TrimPrefix(string(r), "monthly:"))
+		return addMonthClamped(from, day)
+	case strings.HasPrefix(string(r), "weekly:"):
+		days := make(map[int]bool)
+		for _, day := range strings.Split(strings.TrimPrefix(string(r), "weekly:"), ",") {
+			days[weekdayIndex(day)] = true
+		}
+		for i := 1; i <= 7; i++ {
+			next := from.AddDate(0, 0, i)
+			if days[int(next.Weekday())] {
+				return next
+			}
+		}
+	case strings.HasPrefix(string(r), "every:"):
+		n, _ := strconv.Atoi(strings.TrimPrefix(string(r), "every:"))
+		return from.AddDate(0, 0, max(n, 1))
+	}
+	// a rule edited by hand into something unknown falls back to daily
+	return from.AddDate(0, 0, 1)
+}
+
+func (t *Todo) NextOccurrence(idGenerator IDGenerator, now time.Time) (*Todo, error) {
+	if t.Recurrence == RecurrenceNone {
+		return nil, nil
+	}
+	next, err := NewTodo(idGenerator, t.Title)
+	if err != nil {
+		return nil, err
+	}
+	next.Notes = t.Notes
+	next.Priority = t.Priority
+	next.Tags = append([]string(nil), t.Tags...)
+	next.ParentID = t.ParentID
+	next.Recurrence = t.Recurrence
+	next.Extras = maps.Clone(t.Extras)
+
+	base := startOfDay(now)
+	if t.DueDate != nil {
+		base = *t.DueDate
+	}
+	recurrence := t.Recurrence
+	if recurrence == RecurrenceMonthly {
+		// a month keeps the day it started on, and an occurrence clamped to a shorter month carries that day in its rule
+		recurrence = Recurrence(fmt.Sprintf("monthly:%d", base.Day()))
+	}
+	dueDate := recurrence.Next(base)
+	for dueDate.Before(startOfDay(now)) {
+		dueDate = recurrence.Next(dueDate)
+	}
+	if t.Recurrence == RecurrenceMonthly && dueDate.Day() != base.Day() {
+		next.Recurrence = recurrence
+	}
+	next.DueDate = &dueDate
+	return next, nil
+}
+
+func weekdayIndex(name string) int {
+	for i, weekday := range weekdays {
+		if strings.HasPrefix(name, weekday) && strings.HasPrefix(strings.ToLower(time.Weekday(i).String()), name) {
+			return i
+		}
+	}
+	return -1
+}
+
+func addMonthClamped(t time.Time, day int) time.Time {
+	year, month, _ := t.Date()
+	lastDay := time.Date(year, month+2, 0, 0, 0, 0, 0, t.Location()).Day()
+	return time.Date(year, month+1, min(day, lastDay), 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,172 @@
+package todo
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Recurrence
+		wantErr bool
+	}{
+		{value: "", want: RecurrenceNone},
+		{value: "none", want: RecurrenceNone},
+		{value: "daily", want: RecurrenceDaily},
+		{value: " Weekly ", want: RecurrenceWeekly},
+		{value: "monthly", want: RecurrenceMonthly},
+		{value: "monthly:31", want: "monthly:31"},
+		{value: "monthly:07", want: "monthly:7"},
+		{value: "weekly:fri,mon", want: "weekly:mon,fri"},
+		{value: "weekly:monday,mon,wed", want: "weekly:mon,wed"},
+		{value: "every:3", want: "every:3"},
+		{value: "every:10d", want: "every:10"},
+		{value: "weekly:", wantErr: true},
+		{value: "weekly:funday", wantErr: true},
+		{value: "every:0", wantErr: true},
+		{value: "every:x", wantErr: true},
+		{value: "monthly:0", wantErr: true},
+		{value: "monthly:32", wantErr: true},
+		{value: "monthly:last", wantErr: true},
+		{value: "yearly", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ParseRecurrence(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseRecurrence(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseRecurrence(%q) = %q, want %q", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrenceNext(t *testing.T) {
+	day := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	}
+	tests := []struct {
+		name       string
+		recurrence Recurrence
+		from       time.Time
+		want       time.Time
+	}{
+		{name: "daily", recurrence: RecurrenceDaily, from: day(2026, 10, 15), want: day(2026, 10, 16)},
+		{name: "daily drops the time of day", recurrence: RecurrenceDaily, from: time.Date(2026, 10, 15, 18, 30, 0, 0, time.UTC), want: day(2026, 10, 16)},
+		{name: "weekly", recurrence: RecurrenceWeekly, from: day(2026, 10, 15), want: day(2026, 10, 22)},
+		{name: "monthly", recurrence: RecurrenceMonthly, from: day(2026, 10, 15), want: day(2026, 11, 15)},
+		{name: "monthly clamps to the last day", recurrence: RecurrenceMonthly, from: day(2026, 1, 31), want: day(2026, 2, 28)},
+		{name: "monthly across the year", recurrence: RecurrenceMonthly, from: day(2026, 12, 31), want: day(2027, 1, 31)},
+		{name: "monthly on a day", recurrence: "monthly:31", from: day(2026, 2, 28), want: day(2026, 3, 31)},
+		{name: "monthly on a day clamps to the last day", recurrence: "monthly:31", from: day(2026, 3, 31), want: day(2026, 4, 30)},
+		{name: "weekly on days later this week", recurrence: "weekly:mon,fri", from: day(2026, 10, 13), want: day(2026, 10, 16)},
+		{name: "weekly on days wraps to next week", recurrence: "weekly:mon,fri", from: day(2026, 10, 16), want: day(2026, 10, 19)},
+		{name: "weekly on the same day", recurrence: "weekly:thu", from: day(2026, 10, 15), want: day(2026, 10, 22)},
+		{name: "every n days", recurrence: "every:3", from: day(2026, 10, 30), want: day(2026, 11, 2)},
+		{name: "unknown rule falls back to daily", recurrence: "fortnightly", from: day(2026, 10, 15), want: day(2026, 10, 16)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.recurrence.Next(tt.from); !got.Equal(tt.want) {
+				t.Errorf("%q.Next(%s) = %s, want %s", tt.recurrence, tt.from, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextOccurrence(t *testing.T) {
+	now := time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC)
+	day := func(month time.Month, day int) *time.Time {
+		d := time.Date(2026, month, day, 0, 0, 0, 0, time.UTC)
+		return &d
+	}
+	tests := []struct {
+		name       string
+		recurrence Recurrence
+		dueDate    *time.Time
+		want       *time.Time
+	}{
+		{name: "no recurrence", recurrence: RecurrenceNone, dueDate: day(10, 15), want: nil},
+		{name: "follows the due date", recurrence: RecurrenceWeekly, dueDate: day(10, 14), want: day(10, 21)},
+		{name: "starts from today without a due date", recurrence: RecurrenceDaily, want: day(10, 16)},
+		{name: "skips occurrences in the past", recurrence: RecurrenceDaily, dueDate: day(10, 1), want: day(10, 15)},
+		{name: "skips past weeks", recurrence: RecurrenceWeekly, dueDate: day(9, 1), want: day(10, 20)},
+		{name: "monthly keeps the day of the due date", recurrence: RecurrenceMonthly, dueDate: day(8, 31), want: day(10, 31)},
+		{name: "monthly on a day comes back after clamping", recurrence: "monthly:31", dueDate: day(9, 30), want: day(10, 31)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			todo := &Todo{
+				ID:         "recurring",
+				Title:      "Water the plants",
+				Notes:      "the ones on the balcony",
+				Status:     StatusDone,
+				Priority:   PriorityMedium,
+				Tags:       []string{"home"},
+				ParentID:   "garden",
+				Recurrence: tt.recurrence,
+				DueDate:    tt.dueDate,
+				Extras:     map[string]string{"key": "value"},
+			}
+			next, err := todo.NextOccurrence(NewShortIDGenerator(), now)
+			if err != nil {
+				t.Fatalf("NextOccurrence returned error: %v", err)
+			}
+			if tt.want == nil {
+				if next != nil {
+					t.Fatalf("NextOccurrence = %+v, want nil", next)
+				}
+				return
+			}
+			if next == nil {
+				t.Fatal("NextOccurrence = nil, want a todo")
+			}
+			if next.DueDate == nil || !next.DueDate.Equal(*tt.want) {
+				t.Errorf("next due date = %v, want %s", next.DueDate, tt.want)
+			}
+			if next.ID == todo.ID || next.Status != StatusTodo {
+				t.Errorf("next occurrence is not a new open todo: id %q, status %q", next.ID, next.Status)
+			}
+			if next.Title != todo.Title || next.Notes != todo.Notes || next.Priority != todo.Priority || next.ParentID != todo.ParentID || next.Recurrence != todo.Recurrence {
+				t.Errorf("next occurrence %+v does not carry over the fields of %+v", next, todo)
+			}
+			next.Tags[0] = "changed"
+			next.Extras["key"] = "changed"
+			if todo.Tags[0] != "home" || todo.Extras["key"] != "value" {
+				t.Error("next occurrence shares tags or extras with the original todo")
+			}
+		})
+	}
+}
+
+func TestNextOccurrenceMonthlyDoesNotDrift(t *testing.T) {
+	due := time.Date(2027, 1, 31, 0, 0, 0, 0, time.UTC)
+	todo := &Todo{ID: "rent", Title: "Pay rent", Recurrence: RecurrenceMonthly, DueDate: &due}
+	want := []string{"2027-02-28", "2027-03-31", "2027-04-30", "2027-05-31"}
+	for _, date := range want {
+		next, err := todo.NextOccurrence(NewShortIDGenerator(), due)
+		if err != nil {
+			t.Fatalf("NextOccurrence returned error: %v", err)
+		}
+		if got := next.DueDate.Format(DueDateLayout); got != date {
+			t.Fatalf("next due date = %s, want %s", got, date)
+		}
+		todo, due = next, *next.DueDate
+	}
+	if todo.Recurrence != "monthly:31" {
+		t.Errorf("recurrence = %q, want it pinned to monthly:31", todo.Recurrence)
+	}
+
+	due = time.Date(2027, 1, 15, 0, 0, 0, 0, time.UTC)
+	todo = &Todo{ID: "report", Title: "Send report", Recurrence: RecurrenceMonthly, DueDate: &due}
+	next, err := todo.NextOccurrence(NewShortIDGenerator(), due)
+	if err != nil {
+		t.Fatalf("NextOccurrence returned error: %v", err)
+	}
+	if next.Recurrence != RecurrenceMonthly {
+		t.Errorf("recurrence = %q, want monthly left as it was when no month is clamped", next.Recurrence)
+	}
+}
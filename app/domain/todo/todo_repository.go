@@ -1,9 +1,6 @@
 package todo
 
 type TodoRepository interface {
-	Save(todo *Todo) error
 	FindAll() ([]*Todo, error)
-	FindByID(id string) (*Todo, error)
-	Update(todo *Todo) error
-	Delete(id string) error
+	Modify(fn func(todos []*Todo) ([]*Todo, error)) error
 }
@@ -0,0 +1,105 @@
+package todo
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+)
+
+type SearchTerm struct {
+	Text    string
+	Negated bool
+}
+
+type SearchQuery struct {
+	Terms []SearchTerm
+}
+
+type queryToken struct {
+	text    string
+	negated bool
+	literal int
+}
+
+func ParseSearchQuery(query string) (*SearchQuery, error) {
+	tokens, err := tokenizeQuery(query)
+	if err != nil {
+		return nil, err
+	}
+	q := &SearchQuery{}
+	for _, token := range tokens {
+		q.Terms = append(q.Terms, SearchTerm{Text: strings.ToLower(token.text), Negated: token.negated})
+	}
+	if len(q.Terms) == 0 {
+		return nil, errors.New("search query is empty")
+	}
+	return q, nil
+}
+
+func (q *SearchQuery) Matches(t *Todo) bool {
+	for _, term := range q.Terms {
+		if t.containsText(term.Text) == term.Negated {
+			return false
+		}
+	}
+	return true
+}
+
+func (q *SearchQuery) Highlights() []string {
+	highlights := make([]string, 0, len(q.Terms))
+	for _, term := range q.Terms {
+		if !term.Negated {
+			highlights = append(highlights, term.Text)
+		}
+	}
+	return highlights
+}
+
+func (t *Todo) containsText(text string) bool {
+	return strings.Contains(strings.ToLower(t.Title+"\n"+t.Notes), text)
+}
+
+func tokenizeQuery(query string) ([]*queryToken, error) {
+	tokens := make([]*queryToken, 0)
+	runes := []rune(query)
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+		token := &queryToken{literal: -1}
+		if runes[i] == '-' && i+1 < len(runes) && !unicode.IsSpace(runes[i+1]) {
+			token.negated = true
+			i++
+		}
+		var text strings.Builder
+		for i < len(runes) && !unicode.IsSpace(runes[i]) {
+			if runes[i] != '"' {
+				text.WriteRune(runes[i])
+				i++
+				continue
+			}
+			end := i + 1
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			if end == len(runes) {
+				return nil, errors.New("unterminated quote in query")
+			}
+			if token.literal < 0 {
+				token.literal = text.Len()
+			}
+			text.WriteString(string(runes[i+1 : end]))
+			i = end + 1
+		}
+		token.text = text.String()
+		if token.literal < 0 {
+			token.literal = len(token.text)
+		}
+		if strings.TrimSpace(token.text) == "" {
+			continue
+		}
+		tokens = append(tokens, token)
+	}
+	return tokens, nil
+}
@@ -0,0 +1,153 @@
+package todo
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+type SortKey struct {
+	Field      string
+	Descending bool
+}
+
+type SortSpec []SortKey
+
+type sortField struct {
+	compare func(a *Todo, b *Todo) int
+	missing func(t *Todo) bool
+}
+
+var statusOrder = map[Status]int{
+	StatusTodo:       0,
+	StatusInProgress: 1,
+	StatusWaiting:    2,
+	StatusDone:       3,
+	StatusCancelled:  4,
+}
+
+var sortFields = map[string]*sortField{
+	"created": {
+		compare: func(a *Todo, b *Todo) int { return a.CreatedAt.Compare(b.CreatedAt) },
+		missing: func(t *Todo) bool { return t.CreatedAt.IsZero() },
+	},
+	"title": {
+		compare: func(a *Todo, b *Todo) int { return strings.Compare(strings.ToLower(a.Title), strings.ToLower(b.Title)) },
+	},
+	"done": {
+		compare: func(a *Todo, b *Todo) int { return cmp.Compare(boolRank(a.IsDone()), boolRank(b.IsDone())) },
+	},
+	"status": {
+		compare: func(a *Todo, b *Todo) int { return cmp.Compare(statusOrder[a.Status], statusOrder[b.Status]) },
+	},
+	"priority": {
+		compare: func(a *Todo, b *Todo) int { return cmp.Compare(a.Priority.Rank(), b.Priority.Rank()) },
+	},
+	"due": {
+		compare: func(a *Todo, b *Todo) int { return a.DueDate.Compare(*b.DueDate) },
+		missing: func(t *Todo) bool { return t.DueDate == nil },
+	},
+	"completed": {
+		compare: func(a *Todo, b *Todo) int { return a.CompletedAt.Compare(*b.CompletedAt) },
+		missing: func(t *Todo) bool { return t.CompletedAt == nil },
+	},
+}
+
+func ParseSortSpec(value string) (SortSpec, error) {
+	spec := make(SortSpec, 0)
+	for _, part := range strings.Split(value, ",") {
+		part = strings.ToLower(strings.TrimSpace(part))
+		if part == "" {
+			continue
+		}
+		field, direction, _ := strings.Cut(part, ":")
+		if _, ok := sortFields[field]; !ok {
+			return nil, fmt.Errorf("invalid sort field %q (expected %s)", field, strings.Join(SortFieldNames(), ", "))
+		}
+		key := SortKey{Field: field}
+		switch direction {
+		case "", "asc":
+		case "desc":
+			key.Descending = true
+		default:
+			return nil, fmt.Errorf("invalid sort direction %q for %q (expected asc or desc)", direction, field)
+		}
+		spec = append(spec, key)
+	}
+	return spec, nil
+}
+
+func (s SortSpec) Sorted(todos []*Todo) []*Todo {
+	sorted := slices.Clone(todos)
+	s.Sort(sorted)
+	return sorted
+}
+
+func (s SortSpec) Positions(todos []*Todo) map[string]int {
+	positions := make(map[string]int, len(todos))
+	for i, t := range s.Sorted(todos) {
+		positions[t.ID] = i + 1
+	}
+	return positions
+}
+
+func SortFieldNames() []string {
+	names := make([]string, 0, len(sortFields))
+	for name := range sortFields {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+func (s SortSpec) Sort(todos []*Todo) {
+	if len(s) == 0 {
+		return
+	}
+	sort.SliceStable(todos, func(i, j int) bool {
+		return s.compare(todos[i], todos[j]) < 0
+	})
+}
+
+func (s SortSpec) String() string {
+	keys := make([]string, len(s))
+	for i, key := range s {
+		keys[i] = key.Field
+		if key.Descending {
+			keys[i] += ":desc"
+		}
+	}
+	return strings.Join(keys, ",")
+}
+
+func (s SortSpec) compare(a *Todo, b *Todo) int {
+	for _, key := range s {
+		field := sortFields[key.Field]
+		if field.missing != nil {
+			aMissing, bMissing := field.missing(a), field.missing(b)
+			if aMissing || bMissing {
+				if c := cmp.Compare(boolRank(aMissing), boolRank(bMissing)); c != 0 {
+					return c
+				}
+				continue
+			}
+		}
+		c := field.compare(a, b)
+		if key.Descending {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func boolRank(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
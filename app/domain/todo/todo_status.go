@@ -0,0 +1,92 @@
+package todo
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+type Status string
+
+const (
+	StatusTodo       Status = "todo"
+	StatusInProgress Status = "in_progress"
+	StatusWaiting    Status = "waiting"
+	StatusDone       Status = "done"
+	StatusCancelled  Status = "cancelled"
+)
+
+var transitions = map[Status][]Status{
+	StatusTodo:       {StatusInProgress, StatusWaiting, StatusDone, StatusCancelled},
+	StatusInProgress: {StatusTodo, StatusWaiting, StatusDone, StatusCancelled},
+	StatusWaiting:    {StatusTodo, StatusInProgress, StatusDone, StatusCancelled},
+	StatusDone:       {StatusTodo},
+	StatusCancelled:  {StatusTodo},
+}
+
+func ParseStatus(value string) (Status, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "todo", "open":
+		return StatusTodo, nil
+	case "in_progress", "in-progress", "doing", "started":
+		return StatusInProgress, nil
+	case "waiting", "wait":
+		return StatusWaiting, nil
+	case "done", "completed":
+		return StatusDone, nil
+	case "cancelled", "canceled":
+		return StatusCancelled, nil
+	default:
+		return "", fmt.Errorf("invalid status %q (expected todo, in_progress, waiting, done or cancelled)", value)
+	}
+}
+
+func (s Status) IsClosed() bool {
+	return s == StatusDone || s == StatusCancelled
+}
+
+func (s Status) CanTransitionTo(next Status) bool {
+	for _, allowed := range transitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+func (s Status) Label() string {
+	return strings.ReplaceAll(string(s), "_", " ")
+}
+
+func (t *Todo) IsDone() bool {
+	return t.Status == StatusDone
+}
+
+func (t *Todo) IsClosed() bool {
+	return t.Status.IsClosed()
+}
+
+func (t *Todo) SetStatus(status Status, now time.Time) error {
+	if _, ok := transitions[status]; !ok {
+		return fmt.Errorf("invalid status %q", status)
+	}
+	if t.Status == status {
+		return fmt.Errorf("todo is already %s", status.Label())
+	}
+	if !t.Status.CanTransitionTo(status) {
+		return fmt.Errorf("cannot change status from %s to %s", t.Status.Label(), status.Label())
+	}
+	t.Status = status
+	t.CompletedAt = nil
+	if status.IsClosed() {
+		t.CompletedAt = &now
+	}
+	return nil
+}
+
+func (t *Todo) ToggledStatus() Status {
+	if t.IsClosed() {
+		return StatusTodo
+	}
+	return StatusDone
+}
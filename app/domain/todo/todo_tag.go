@@ -0,0 +1,74 @@
+package todo
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+func NormalizeTag(tag string) (string, error) {
+	normalized := strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "+"))
+	if normalized == "" {
+		return "", fmt.Errorf("invalid tag %q: tag is empty", tag)
+	}
+	if strings.IndexFunc(normalized, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ','
+	}) >= 0 {
+		return "", fmt.Errorf("invalid tag %q: tag must not contain spaces or commas", tag)
+	}
+	return normalized, nil
+}
+
+func NormalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		n, err := NormalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		if seen[n] {
+			continue
+		}
+		seen[n] = true
+		normalized = append(normalized, n)
+	}
+	return normalized, nil
+}
+
+func ExtractTags(title string) (string, []string) {
+	var words []string
+	var tags []string
+	for _, word := range strings.Fields(title) {
+		if len(word) > 1 && strings.HasPrefix(word, "+") {
+			tags = append(tags, word)
+			continue
+		}
+		words = append(words, word)
+	}
+	if len(tags) == 0 {
+		return title, nil
+	}
+	return strings.Join(words, " "), tags
+}
+
+func (t *Todo) HasTag(tag string) bool {
+	for _, tt := range t.Tags {
+		if tt == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Todo) MatchTags(tags []string, matchAny bool) bool {
+	if len(tags) == 0 {
+		return true
+	}
+	for _, tag := range tags {
+		if t.HasTag(tag) == matchAny {
+			return matchAny
+		}
+	}
+	return !matchAny
+}
@@ -0,0 +1,68 @@
+package todo
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func (t *Todo) IsTrashed() bool {
+	return t.DeletedAt != nil
+}
+
+func (t *Todo) Trash(now time.Time) {
+	t.DeletedAt = &now
+}
+
+func (t *Todo) Restore() {
+	t.DeletedAt = nil
+}
+
+func (t *Todo) TrashedBefore(cutoff time.Time) bool {
+	return t.IsTrashed() && !t.DeletedAt.After(cutoff)
+}
+
+func ActiveTodos(todos []*Todo) []*Todo {
+	active := make([]*Todo, 0, len(todos))
+	for _, t := range todos {
+		if !t.IsTrashed() {
+			active = append(active, t)
+		}
+	}
+	return active
+}
+
+func TrashedTodos(todos []*Todo) []*Todo {
+	trashed := make([]*Todo, 0)
+	for _, t := range todos {
+		if t.IsTrashed() {
+			trashed = append(trashed, t)
+		}
+	}
+	return trashed
+}
+
+func ParseAge(value string) (time.Duration, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	if value == "" {
+		return 0, nil
+	}
+	for suffix, unit := range map[string]time.Duration{
+		"d": 24 * time.Hour,
+		"w": 7 * 24 * time.Hour,
+	} {
+		if n, ok := strings.CutSuffix(value, suffix); ok {
+			count, err := strconv.Atoi(n)
+			if err != nil || count < 0 {
+				return 0, fmt.Errorf("invalid age %q (use e.g. 30d, 2w or 12h)", value)
+			}
+			return time.Duration(count) * unit, nil
+		}
+	}
+	age, err := time.ParseDuration(value)
+	if err != nil || age < 0 {
+		return 0, fmt.Errorf("invalid age %q (use e.g. 30d, 2w or 12h)", value)
+	}
+	return age, nil
+}
@@ -0,0 +1,105 @@
+package todo
+
+import (
+	"errors"
+)
+
+type TreeItem struct {
+	Todo  *Todo
+	Depth int
+}
+
+type Progress struct {
+	Done  int
+	Total int
+}
+
+func (t *Todo) SetParent(parent *Todo) error {
+	if parent == nil {
+		t.ParentID = ""
+		return nil
+	}
+	if parent.ID == t.ID {
+		return errors.New("a todo cannot be its own parent")
+	}
+	if parent.IsTrashed() {
+		return errors.New("parent todo is in the trash")
+	}
+	t.ParentID = parent.ID
+	return nil
+}
+
+func FlattenTree(todos []*Todo) []*TreeItem {
+	ids := make(map[string]bool, len(todos))
+	for _, t := range todos {
+		ids[t.ID] = true
+	}
+	children := make(map[string][]*Todo)
+	roots := make([]*Todo, 0)
+	for _, t := range todos {
+		if t.ParentID == "" || !ids[t.ParentID] {
+			roots = append(roots, t)
+			continue
+		}
+		children[t.ParentID] = append(children[t.ParentID], t)
+	}
+
+	items := make([]*TreeItem, 0, len(todos))
+	visited := make(map[string]bool, len(todos))
+	var walk func(t *Todo, depth int)
+	walk = func(t *Todo, depth int) {
+		if visited[t.ID] {
+			return
+		}
+		visited[t.ID] = true
+		items = append(items, &TreeItem{Todo: t, Depth: depth})
+		for _, child := range children[t.ID] {
+			walk(child, depth+1)
+		}
+	}
+	for _, t := range roots {
+		walk(t, 0)
+	}
+	// todos caught in a parent cycle are never reached from a root
+	for _, t := range todos {
+		walk(t, 0)
+	}
+	return items
+}
+
+func Descendants(todos []*Todo, id string) []*Todo {
+	descendants := make([]*Todo, 0)
+	seen := map[string]bool{id: true}
+	queue := []string{id}
+	for len(queue) > 0 {
+		parentID := queue[0]
+		queue = queue[1:]
+		for _, t := range todos {
+			if t.ParentID == parentID && !seen[t.ID] {
+				seen[t.ID] = true
+				descendants = append(descendants, t)
+				queue = append(queue, t.ID)
+			}
+		}
+	}
+	return descendants
+}
+
+func ChildProgress(todos []*Todo) map[string]Progress {
+	progress := make(map[string]Progress)
+	for _, t := range todos {
+		if t.ParentID == "" {
+			continue
+		}
+		if t.Status == StatusCancelled {
+			continue
+		}
+		p := progress[t.ParentID]
+		p.Total++
+		if t.IsDone() {
+			p.Done++
+		}
+		progress[t.ParentID] = p
+	}
+	return progress
+}
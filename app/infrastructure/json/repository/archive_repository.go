@@ -0,0 +1,115 @@
+package repository
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/yanosea/gct/app/config"
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+
+	"github.com/yanosea/gct/pkg/proxy"
+	"github.com/yanosea/gct/pkg/utility"
+)
+
+const (
+	archiveFilePrefix = "archive-"
+	archiveFileSuffix = ".json"
+)
+
+type ArchiveRepository struct {
+	archiveDirPath string
+	lockTimeout    time.Duration
+	fileutil       utility.FileUtil
+	json           proxy.Json
+	os             proxy.Os
+}
+
+func NewArchiveRepository(
+	conf *config.TodoConfig,
+	fileutil utility.FileUtil,
+	json proxy.Json,
+	os proxy.Os,
+) (todoDomain.ArchiveRepository, error) {
+	dbFilePath, err := resolveDBFilePath(conf, fileutil)
+	if err != nil {
+		return nil, err
+	}
+	return &ArchiveRepository{
+		archiveDirPath: filepath.Dir(dbFilePath),
+		lockTimeout:    conf.LockTimeout,
+		fileutil:       fileutil,
+		json:           json,
+		os:             os,
+	}, nil
+}
+
+func (r *ArchiveRepository) Append(period string, todos []*todoDomain.Todo) error {
+	if _, err := time.Parse(todoDomain.ArchivePeriodLayout, period); err != nil {
+		return fmt.Errorf("invalid archive period %q", period)
+	}
+	archiveFilePath := filepath.Join(r.archiveDirPath, archiveFilePrefix+period+archiveFileSuffix)
+	return r.fileutil.WithLock(archiveFilePath, r.lockTimeout, func() error {
+		archived, err := r.readArchive(archiveFilePath)
+		if err != nil && !r.os.IsNotExist(err) {
+			return err
+		}
+
+		// a todo archived again after an interrupted run replaces its earlier copy
+		archived = slices.DeleteFunc(archived, func(a *todoDomain.Todo) bool {
+			return slices.ContainsFunc(todos, func(t *todoDomain.Todo) bool { return t.ID == a.ID })
+		})
+		file, err := r.json.MarshalIndent(&todoDocument{
+			Version: latestSchemaVersion(),
+			Todos:   append(archived, todos...),
+		}, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		return r.fileutil.WriteFileAtomic(archiveFilePath, file, 0644)
+	})
+}
+
+func (r *ArchiveRepository) FindAll() ([]*todoDomain.Todo, error) {
+	entries, err := r.os.ReadDir(r.archiveDirPath)
+	if err != nil {
+		return nil, err
+	}
+
+	todos := make([]*todoDomain.Todo, 0)
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, archiveFilePrefix) || !strings.HasSuffix(name, archiveFileSuffix) {
+			continue
+		}
+		archived, err := r.readArchive(filepath.Join(r.archiveDirPath, name))
+		if err != nil {
+			return nil, err
+		}
+		todos = append(todos, archived...)
+	}
+
+	return todos, nil
+}
+
+func (r *ArchiveRepository) readArchive(archiveFilePath string) ([]*todoDomain.Todo, error) {
+	file, err := r.os.ReadFile(archiveFilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	file, _, err = migrateDocument(r.json, file)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Base(archiveFilePath), err)
+	}
+
+	var document todoDocument
+	if err := r.json.Unmarshal(file, &document); err != nil {
+		return nil, fmt.Errorf("%s: %w", filepath.Base(archiveFilePath), err)
+	}
+
+	return document.Todos, nil
+}
@@ -0,0 +1,84 @@
+package repository
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/yanosea/gct/app/config"
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+
+	"github.com/yanosea/gct/pkg/proxy"
+	"github.com/yanosea/gct/pkg/utility"
+)
+
+const (
+	journalFileName = "journal.json"
+)
+
+type JournalRepository struct {
+	journalFilePath string
+	lockTimeout     time.Duration
+	fileutil        utility.FileUtil
+	json            proxy.Json
+	os              proxy.Os
+}
+
+func NewJournalRepository(
+	conf *config.TodoConfig,
+	fileutil utility.FileUtil,
+	json proxy.Json,
+	os proxy.Os,
+) (todoDomain.JournalRepository, error) {
+	dbFilePath, err := resolveDBFilePath(conf, fileutil)
+	if err != nil {
+		return nil, err
+	}
+	return &JournalRepository{
+		journalFilePath: filepath.Join(filepath.Dir(dbFilePath), journalFileName),
+		lockTimeout:     conf.LockTimeout,
+		fileutil:        fileutil,
+		json:            json,
+		os:              os,
+	}, nil
+}
+
+func (r *JournalRepository) Load() (*todoDomain.Journal, error) {
+	file, err := r.os.ReadFile(r.journalFilePath)
+	if err != nil {
+		if r.os.IsNotExist(err) {
+			return &todoDomain.Journal{}, nil
+		}
+		return nil, err
+	}
+
+	if file, err = upgradeJournal(r.json, file); err != nil {
+		return nil, err
+	}
+
+	var journal todoDomain.Journal
+	if err := r.json.Unmarshal(file, &journal); err != nil {
+		return nil, err
+	}
+
+	return &journal, nil
+}
+
+func (r *JournalRepository) Update(fn func(journal *todoDomain.Journal) error) error {
+	return r.fileutil.WithLock(r.journalFilePath, r.lockTimeout, func() error {
+		journal, err := r.Load()
+		if err != nil {
+			return err
+		}
+
+		if err := fn(journal); err != nil {
+			return err
+		}
+
+		file, err := r.json.MarshalIndent(journal, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		return r.fileutil.WriteFileAtomic(r.journalFilePath, file, 0644)
+	})
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/yanosea/gct/pkg/proxy"
+)
+
+type migration struct {
+	description string
+	migrate     func(document any) (any, error)
+}
+
+var migrations = []*migration{
+	{
+		description: "wrap the todo array in a versioned envelope",
+		migrate: func(document any) (any, error) {
+			todos, ok := document.([]any)
+			if !ok {
+				return nil, errors.New("expected a todo array")
+			}
+			return map[string]any{
+				"version": 1,
+				"todos":   todos,
+			}, nil
+		},
+	},
+	{
+		description: "replace the done flag with a workflow status",
+		migrate: func(document any) (any, error) {
+			envelope, ok := document.(map[string]any)
+			if !ok {
+				return nil, errors.New("expected a versioned envelope")
+			}
+			todos, _ := envelope["todos"].([]any)
+			for _, todo := range todos {
+				upgradeTodoStatus(todo)
+			}
+			envelope["version"] = 2
+			return envelope, nil
+		},
+	},
+}
+
+func upgradeTodoStatus(todo any) {
+	fields, ok := todo.(map[string]any)
+	if !ok {
+		return
+	}
+	done, hasDone := fields["done"].(bool)
+	delete(fields, "done")
+	if _, ok := fields["status"].(string); ok {
+		return
+	}
+	fields["status"] = "todo"
+	if hasDone && done {
+		fields["status"] = "done"
+	}
+}
+
+func upgradeJournal(json proxy.Json, data []byte) ([]byte, error) {
+	var document map[string]any
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	for _, stack := range []string{"undo", "redo"} {
+		entries, _ := document[stack].([]any)
+		for _, entry := range entries {
+			fields, _ := entry.(map[string]any)
+			changes, _ := fields["changes"].([]any)
+			for _, change := range changes {
+				sides, _ := change.(map[string]any)
+				for _, side := range []string{"before", "after"} {
+					if todo, ok := sides[side]; ok && todo != nil {
+						upgradeTodoStatus(todo)
+					}
+				}
+			}
+		}
+	}
+	return json.MarshalIndent(document, "", "  ")
+}
+
+func latestSchemaVersion() int {
+	return len(migrations)
+}
+
+func schemaVersion(document any) (int, error) {
+	switch d := document.(type) {
+	case []any:
+		return 0, nil
+	case map[string]any:
+		version, ok := d["version"].(float64)
+		if !ok {
+			return 0, errors.New("database has no schema version")
+		}
+		return int(version), nil
+	default:
+		return 0, errors.New("unrecognized database format")
+	}
+}
+
+func migrateDocument(json proxy.Json, data []byte) ([]byte, int, error) {
+	var document any
+	if err := json.Unmarshal(data, &document); err != nil {
+		return nil, 0, err
+	}
+	version, err := schemaVersion(document)
+	if err != nil {
+		return nil, 0, err
+	}
+	if version > latestSchemaVersion() {
+		return nil, 0, fmt.Errorf("database schema version %d is newer than the supported version %d, please update gct", version, latestSchemaVersion())
+	}
+	if version == latestSchemaVersion() {
+		return data, version, nil
+	}
+	for i, m := range migrations[version:] {
+		if document, err = m.migrate(document); err != nil {
+			return nil, 0, fmt.Errorf("failed to migrate database to schema version %d: %w", version+i+1, err)
+		}
+	}
+	migrated, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, 0, err
+	}
+	var typed todoDocument
+	if err := json.Unmarshal(migrated, &typed); err != nil {
+		return nil, 0, err
+	}
+	if migrated, err = json.MarshalIndent(&typed, "", "  "); err != nil {
+		return nil, 0, err
+	}
+	return migrated, version, nil
+}
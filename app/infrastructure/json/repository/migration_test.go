@@ -0,0 +1,138 @@
+package repository
+
+import (
+	"strings"
+	"testing"
+
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+
+	"github.com/yanosea/gct/pkg/proxy"
+)
+
+func TestMigrateDocument(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		wantVersion int
+		wantStatus  map[string]todoDomain.Status
+	}{
+		{
+			name:        "legacy todo array",
+			data:        `[{"id":"a","title":"open","done":false,"created_at":"2026-10-01T00:00:00Z"},{"id":"b","title":"closed","done":true,"created_at":"2026-10-01T00:00:00Z"}]`,
+			wantVersion: 0,
+			wantStatus:  map[string]todoDomain.Status{"a": todoDomain.StatusTodo, "b": todoDomain.StatusDone},
+		},
+		{
+			name:        "empty legacy array",
+			data:        `[]`,
+			wantVersion: 0,
+			wantStatus:  map[string]todoDomain.Status{},
+		},
+		{
+			name:        "version 1 envelope",
+			data:        `{"version":1,"todos":[{"id":"a","title":"open","created_at":"2026-10-01T00:00:00Z"},{"id":"b","title":"closed","done":true,"created_at":"2026-10-01T00:00:00Z"}]}`,
+			wantVersion: 1,
+			wantStatus:  map[string]todoDomain.Status{"a": todoDomain.StatusTodo, "b": todoDomain.StatusDone},
+		},
+		{
+			name:        "version 1 todo that already has a status",
+			data:        `{"version":1,"todos":[{"id":"a","title":"waiting","done":false,"status":"waiting","created_at":"2026-10-01T00:00:00Z"}]}`,
+			wantVersion: 1,
+			wantStatus:  map[string]todoDomain.Status{"a": todoDomain.StatusWaiting},
+		},
+		{
+			name:        "current version",
+			data:        `{"version":2,"todos":[{"id":"a","title":"started","status":"in_progress","created_at":"2026-10-01T00:00:00Z"}]}`,
+			wantVersion: 2,
+			wantStatus:  map[string]todoDomain.Status{"a": todoDomain.StatusInProgress},
+		},
+	}
+	json := proxy.NewJson()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrated, version, err := migrateDocument(json, []byte(tt.data))
+			if err != nil {
+				t.Fatalf("migrateDocument returned error: %v", err)
+			}
+			if version != tt.wantVersion {
+				t.Errorf("migrateDocument version = %d, want %d", version, tt.wantVersion)
+			}
+			var document todoDocument
+			if err := json.Unmarshal(migrated, &document); err != nil {
+				t.Fatalf("migrated document is not valid: %v", err)
+			}
+			if document.Version != latestSchemaVersion() {
+				t.Errorf("migrated document version = %d, want %d", document.Version, latestSchemaVersion())
+			}
+			if len(document.Todos) != len(tt.wantStatus) {
+				t.Fatalf("migrated document has %d todos, want %d", len(document.Todos), len(tt.wantStatus))
+			}
+			for _, todo := range document.Todos {
+				if want := tt.wantStatus[todo.ID]; todo.Status != want {
+					t.Errorf("todo %q status = %q, want %q", todo.ID, todo.Status, want)
+				}
+			}
+			if strings.Contains(string(migrated), `"done":`) {
+				t.Errorf("migrated document still has the done flag: %s", migrated)
+			}
+		})
+	}
+}
+
+func TestMigrateDocumentErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		wantErr string
+	}{
+		{name: "invalid json", data: `{`, wantErr: "unexpected end of JSON input"},
+		{name: "missing version", data: `{"todos":[]}`, wantErr: "no schema version"},
+		{name: "unrecognized format", data: `"todos"`, wantErr: "unrecognized database format"},
+		{name: "newer version", data: `{"version":99,"todos":[]}`, wantErr: "please update gct"},
+		{name: "version 1 without an envelope body", data: `{"version":1,"todos":{"id":"a"}}`, wantErr: "cannot unmarshal"},
+	}
+	json := proxy.NewJson()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := migrateDocument(json, []byte(tt.data))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("migrateDocument error = %v, want it to contain %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestUpgradeJournal(t *testing.T) {
+	data := `{"undo":[{"operation":"toggle","changes":[{"before":{"id":"a","done":false},"after":{"id":"a","done":true}}]}],"redo":[{"operation":"add","changes":[{"before":null,"after":{"id":"b","status":"waiting"}}]}]}`
+	json := proxy.NewJson()
+	upgraded, err := upgradeJournal(json, []byte(data))
+	if err != nil {
+		t.Fatalf("upgradeJournal returned error: %v", err)
+	}
+	var document struct {
+		Undo []*todoDomain.JournalEntry `json:"undo"`
+		Redo []*todoDomain.JournalEntry `json:"redo"`
+	}
+	if err := json.Unmarshal(upgraded, &document); err != nil {
+		t.Fatalf("upgraded journal is not valid: %v", err)
+	}
+	tests := []struct {
+		name string
+		todo *todoDomain.Todo
+		want todoDomain.Status
+	}{
+		{name: "undo before", todo: document.Undo[0].Changes[0].Before, want: todoDomain.StatusTodo},
+		{name: "undo after", todo: document.Undo[0].Changes[0].After, want: todoDomain.StatusDone},
+		{name: "redo after keeps its status", todo: document.Redo[0].Changes[0].After, want: todoDomain.StatusWaiting},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.todo.Status != tt.want {
+				t.Errorf("status = %q, want %q", tt.todo.Status, tt.want)
+			}
+		})
+	}
+	if document.Redo[0].Changes[0].Before != nil {
+		t.Error("a missing before side was filled in")
+	}
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/yanosea/gct/app/config"
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+
+	"github.com/yanosea/gct/pkg/proxy"
+	"github.com/yanosea/gct/pkg/utility"
+)
+
+type TodoMigrator struct {
+	dbFilePath  string
+	lockTimeout time.Duration
+	fileutil    utility.FileUtil
+	json        proxy.Json
+	os          proxy.Os
+}
+
+func NewTodoMigrator(
+	conf *config.TodoConfig,
+	fileutil utility.FileUtil,
+	json proxy.Json,
+	os proxy.Os,
+) (todoDomain.TodoMigrator, error) {
+	dbFilePath, err := resolveDBFilePath(conf, fileutil)
+	if err != nil {
+		return nil, err
+	}
+	return &TodoMigrator{
+		dbFilePath:  dbFilePath,
+		lockTimeout: conf.LockTimeout,
+		fileutil:    fileutil,
+		json:        json,
+		os:          os,
+	}, nil
+}
+
+func (m *TodoMigrator) Plan() (*todoDomain.MigrationPlan, error) {
+	data, err := m.os.ReadFile(m.dbFilePath)
+	if err != nil {
+		if m.os.IsNotExist(err) {
+			return m.newPlan(latestSchemaVersion(), nil)
+		}
+		return nil, err
+	}
+	migrated, version, err := migrateDocument(m.json, data)
+	if err != nil {
+		return nil, err
+	}
+	return m.newPlan(version, migrated)
+}
+
+func (m *TodoMigrator) Migrate() (*todoDomain.MigrationPlan, error) {
+	var plan *todoDomain.MigrationPlan
+	err := m.fileutil.WithLock(m.dbFilePath, m.lockTimeout, func() error {
+		data, err := m.os.ReadFile(m.dbFilePath)
+		if err != nil {
+			if m.os.IsNotExist(err) {
+				plan, err = m.newPlan(latestSchemaVersion(), nil)
+				return err
+			}
+			return err
+		}
+		migrated, version, err := migrateDocument(m.json, data)
+		if err != nil {
+			return err
+		}
+		if plan, err = m.newPlan(version, migrated); err != nil {
+			return err
+		}
+		if len(plan.Steps) == 0 {
+			return nil
+		}
+		if err := m.fileutil.WriteFileAtomic(plan.BackupFilePath, data, 0644); err != nil {
+			return err
+		}
+		return m.fileutil.WriteFileAtomic(m.dbFilePath, migrated, 0644)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+func (m *TodoMigrator) newPlan(version int, migrated []byte) (*todoDomain.MigrationPlan, error) {
+	plan := &todoDomain.MigrationPlan{
+		CurrentVersion: version,
+		TargetVersion:  latestSchemaVersion(),
+		Steps:          make([]*todoDomain.MigrationStep, 0),
+	}
+	for i, mg := range migrations[version:] {
+		plan.Steps = append(plan.Steps, &todoDomain.MigrationStep{
+			Version:     version + i + 1,
+			Description: mg.description,
+		})
+	}
+	if migrated != nil {
+		var document todoDocument
+		if err := m.json.Unmarshal(migrated, &document); err != nil {
+			return nil, err
+		}
+		plan.TodoCount = len(document.Todos)
+	}
+	if len(plan.Steps) > 0 {
+		plan.BackupFilePath = fmt.Sprintf("%s.v%d%s", m.dbFilePath, version, backupFileSuffix)
+	}
+	return plan, nil
+}
@@ -1,9 +1,9 @@
 package repository
 
 import (
-	"errors"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/yanosea/gct/app/config"
 	todoDomain "github.com/yanosea/gct/app/domain/todo"
@@ -13,13 +13,21 @@ import (
 )
 
 const (
-	dbFileName = "todos.json"
+	dbFileName       = "todos.json"
+	backupFileSuffix = ".bak"
 )
 
+type todoDocument struct {
+	Version int                `json:"version"`
+	Todos   []*todoDomain.Todo `json:"todos"`
+}
+
 type TodoRepository struct {
-	dbFilePath string
-	json       proxy.Json
-	os         proxy.Os
+	dbFilePath  string
+	lockTimeout time.Duration
+	fileutil    utility.FileUtil
+	json        proxy.Json
+	os          proxy.Os
 }
 
 func NewTodoRepository(
@@ -28,34 +36,44 @@ func NewTodoRepository(
 	json proxy.Json,
 	os proxy.Os,
 ) (todoDomain.TodoRepository, error) {
-	xdgDataHome, err := fileutil.GetXDGDataHome()
+	dbFilePath, err := resolveDBFilePath(conf, fileutil)
 	if err != nil {
 		return nil, err
 	}
-	dbFileDirPath := strings.Replace(conf.DBDirPath, "XDG_DATA_HOME", xdgDataHome, 1)
-	if err := fileutil.MkdirIfNotExist(dbFileDirPath); err != nil {
-		return nil, err
+	r := &TodoRepository{
+		dbFilePath:  dbFilePath,
+		lockTimeout: conf.LockTimeout,
+		fileutil:    fileutil,
+		json:        json,
+		os:          os,
 	}
-	dbFilePath := filepath.Join(dbFileDirPath, dbFileName)
-	if _, err := os.Stat(dbFilePath); os.IsNotExist(err) {
-		if err := fileutil.InitializeJSONFile(dbFilePath, []*todoDomain.Todo{}); err != nil {
+	if _, err := os.Stat(r.dbFilePath); os.IsNotExist(err) {
+		if err := r.withLock(func() error {
+			if _, err := os.Stat(r.dbFilePath); os.IsNotExist(err) {
+				return fileutil.InitializeJSONFile(r.dbFilePath, &todoDocument{
+					Version: latestSchemaVersion(),
+					Todos:   []*todoDomain.Todo{},
+				})
+			}
+			return nil
+		}); err != nil {
 			return nil, err
 		}
 	}
-	return &TodoRepository{
-		dbFilePath: dbFilePath,
-		json:       json,
-		os:         os,
-	}, nil
-}
-
-func (r *TodoRepository) Save(todo *todoDomain.Todo) error {
-	todos, err := r.FindAll()
+	migrator, err := NewTodoMigrator(conf, fileutil, json, os)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	plan, err := migrator.Plan()
+	if err != nil {
+		return nil, err
+	}
+	if len(plan.Steps) > 0 {
+		if _, err := migrator.Migrate(); err != nil {
+			return nil, err
+		}
 	}
-	todos = append(todos, todo)
-	return r.writeTodos(todos)
+	return r, nil
 }
 
 func (r *TodoRepository) FindAll() ([]*todoDomain.Todo, error) {
@@ -64,59 +82,36 @@ func (r *TodoRepository) FindAll() ([]*todoDomain.Todo, error) {
 		return nil, err
 	}
 
-	var todos []*todoDomain.Todo
-	if err := r.json.Unmarshal(file, &todos); err != nil {
-		return nil, err
-	}
-
-	return todos, nil
-}
-
-func (r *TodoRepository) FindByID(id string) (*todoDomain.Todo, error) {
-	todos, err := r.FindAll()
+	file, _, err = migrateDocument(r.json, file)
 	if err != nil {
 		return nil, err
 	}
 
-	for _, todo := range todos {
-		if todo.ID == id {
-			return todo, nil
-		}
+	var document todoDocument
+	if err := r.json.Unmarshal(file, &document); err != nil {
+		return nil, err
 	}
 
-	return nil, errors.New("todo not found")
+	return document.Todos, nil
 }
 
-func (r *TodoRepository) Update(todo *todoDomain.Todo) error {
-	todos, err := r.FindAll()
-	if err != nil {
-		return err
-	}
+func (r *TodoRepository) Modify(fn func(todos []*todoDomain.Todo) ([]*todoDomain.Todo, error)) error {
+	return r.withLock(func() error {
+		todos, err := r.FindAll()
+		if err != nil {
+			return err
+		}
 
-	for i, t := range todos {
-		if t.ID == todo.ID {
-			todos[i] = todo
-			return r.writeTodos(todos)
+		if todos, err = fn(todos); err != nil {
+			return err
 		}
-	}
 
-	return errors.New("todo not found")
+		return r.writeTodos(todos)
+	})
 }
 
-func (r *TodoRepository) Delete(id string) error {
-	todos, err := r.FindAll()
-	if err != nil {
-		return err
-	}
-
-	for i, todo := range todos {
-		if todo.ID == id {
-			todos = append(todos[:i], todos[i+1:]...)
-			return r.writeTodos(todos)
-		}
-	}
-
-	return errors.New("todo not found")
+func (r *TodoRepository) withLock(fn func() error) error {
+	return r.fileutil.WithLock(r.dbFilePath, r.lockTimeout, fn)
 }
 
 func (r *TodoRepository) writeTodos(todos []*todoDomain.Todo) error {
@@ -124,14 +119,37 @@ func (r *TodoRepository) writeTodos(todos []*todoDomain.Todo) error {
 		return err
 	}
 
-	file, err := r.json.MarshalIndent(todos, "", "  ")
+	file, err := r.json.MarshalIndent(&todoDocument{
+		Version: latestSchemaVersion(),
+		Todos:   todos,
+	}, "", "  ")
 	if err != nil {
 		return err
 	}
 
-	if err := r.os.WriteFile(r.dbFilePath, file, 0644); err != nil {
+	if current, err := r.os.ReadFile(r.dbFilePath); err == nil {
+		if err := r.fileutil.WriteFileAtomic(r.dbFilePath+backupFileSuffix, current, 0644); err != nil {
+			return err
+		}
+	} else if !r.os.IsNotExist(err) {
+		return err
+	}
+
+	if err := r.fileutil.WriteFileAtomic(r.dbFilePath, file, 0644); err != nil {
 		return err
 	}
 
 	return nil
 }
+
+func resolveDBFilePath(conf *config.TodoConfig, fileutil utility.FileUtil) (string, error) {
+	xdgDataHome, err := fileutil.GetXDGDataHome()
+	if err != nil {
+		return "", err
+	}
+	dbFileDirPath := strings.Replace(conf.DBDirPath, "XDG_DATA_HOME", xdgDataHome, 1)
+	if err := fileutil.MkdirIfNotExist(dbFileDirPath); err != nil {
+		return "", err
+	}
+	return filepath.Join(dbFileDirPath, dbFileName), nil
+}
@@ -0,0 +1,2 @@
+// Package repository is the todo.txt interface layer of the todo app.
+package repository
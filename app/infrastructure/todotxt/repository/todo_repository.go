@@ -0,0 +1,198 @@
+package repository
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/yanosea/gct/app/config"
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+
+	"github.com/yanosea/gct/pkg/proxy"
+	"github.com/yanosea/gct/pkg/todotxt"
+	"github.com/yanosea/gct/pkg/utility"
+)
+
+const (
+	backupFileSuffix = ".bak"
+)
+
+type TodoRepository struct {
+	todoTxtFilePath string
+	lockTimeout     time.Duration
+	fileutil        utility.FileUtil
+	os              proxy.Os
+}
+
+func NewTodoRepository(
+	conf *config.TodoConfig,
+	fileutil utility.FileUtil,
+	os proxy.Os,
+) (todoDomain.TodoRepository, error) {
+	todoTxtFilePath, err := filepath.Abs(conf.TodoTxtFile)
+	if err != nil {
+		return nil, err
+	}
+	return &TodoRepository{
+		todoTxtFilePath: todoTxtFilePath,
+		lockTimeout:     conf.LockTimeout,
+		fileutil:        fileutil,
+		os:              os,
+	}, nil
+}
+
+// todoLine is a line of todo.txt as it was read, so that lines gct did not change are written back untouched.
+type todoLine struct {
+	text string
+	task *todotxt.Task
+	todo *todoDomain.Todo
+}
+
+func (r *TodoRepository) FindAll() ([]*todoDomain.Todo, error) {
+	lines, err := r.readLines()
+	if err != nil {
+		return nil, err
+	}
+
+	return todosOf(lines), nil
+}
+
+func (r *TodoRepository) Modify(fn func(todos []*todoDomain.Todo) ([]*todoDomain.Todo, error)) error {
+	return r.withLock(func() error {
+		lines, err := r.readLines()
+		if err != nil {
+			return err
+		}
+
+		todos, err := fn(todosOf(lines))
+		if err != nil {
+			return err
+		}
+
+		return r.writeTodos(lines, todos)
+	})
+}
+
+func (r *TodoRepository) readLines() ([]*todoLine, error) {
+	file, err := r.os.ReadFile(r.todoTxtFilePath)
+	if r.os.IsNotExist(err) {
+		return []*todoLine{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(file) == 0 {
+		return []*todoLine{}, nil
+	}
+
+	now := time.Now()
+	occurrences := make(map[string]int)
+	lines := make([]*todoLine, 0)
+	for i, text := range strings.Split(strings.TrimSuffix(string(file), "\n"), "\n") {
+		line := &todoLine{text: text}
+		lines = append(lines, line)
+		trimmed := strings.TrimSuffix(text, "\r")
+		if strings.TrimSpace(trimmed) == "" {
+			continue
+		}
+		if line.task, err = todotxt.Parse(trimmed); err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", r.todoTxtFilePath, i+1, err)
+		}
+		occurrences[trimmed]++
+		line.todo = todoFromTask(line.task, trimmed, occurrences[trimmed], now)
+	}
+
+	return lines, nil
+}
+
+func todosOf(lines []*todoLine) []*todoDomain.Todo {
+	todos := make([]*todoDomain.Todo, 0, len(lines))
+	for _, line := range lines {
+		if line.todo != nil {
+			todos = append(todos, line.todo.Clone())
+		}
+	}
+	return todos
+}
+
+func (r *TodoRepository) withLock(fn func() error) error {
+	if err := r.os.MkdirAll(filepath.Dir(r.todoTxtFilePath), 0755); err != nil {
+		return err
+	}
+	return r.fileutil.WithLock(r.todoTxtFilePath, r.lockTimeout, fn)
+}
+
+func (r *TodoRepository) writeTodos(lines []*todoLine, todos []*todoDomain.Todo) error {
+	kept := make(map[string]bool, len(todos))
+	pending := make(map[string]*todoDomain.Todo, len(todos))
+	for _, todo := range todos {
+		kept[todo.ID] = true
+		pending[todo.ID] = todo
+	}
+	now := time.Now()
+	encode := func(todo *todoDomain.Todo, line *todoLine) (string, error) {
+		if line != nil && reflect.DeepEqual(todo, line.todo) {
+			return line.text, nil
+		}
+		var original *todotxt.Task
+		if line != nil {
+			original = line.task
+		}
+		task, err := taskFromTodo(todo, original)
+		if err != nil {
+			return "", err
+		}
+		text := task.String()
+		// todo.txt keeps dates rather than times, so the todo takes the form the next read will give it
+		if task, err = todotxt.Parse(text); err != nil {
+			return "", err
+		}
+		*todo = *todoFromTask(task, text, 1, now)
+		return text, nil
+	}
+
+	var file strings.Builder
+	for _, line := range lines {
+		if line.todo == nil {
+			file.WriteString(line.text + "\n")
+			continue
+		}
+		todo, ok := pending[line.todo.ID]
+		if !ok {
+			// a line sharing its ID with one already written stays as it is
+			if kept[line.todo.ID] {
+				file.WriteString(line.text + "\n")
+			}
+			continue
+		}
+		delete(pending, todo.ID)
+		text, err := encode(todo, line)
+		if err != nil {
+			return err
+		}
+		file.WriteString(text + "\n")
+	}
+	for _, todo := range todos {
+		if _, ok := pending[todo.ID]; !ok {
+			continue
+		}
+		text, err := encode(todo, nil)
+		if err != nil {
+			return err
+		}
+		file.WriteString(text + "\n")
+	}
+
+	if current, err := r.os.ReadFile(r.todoTxtFilePath); err == nil {
+		if err := r.fileutil.WriteFileAtomic(r.todoTxtFilePath+backupFileSuffix, current, 0644); err != nil {
+			return err
+		}
+	} else if !r.os.IsNotExist(err) {
+		return err
+	}
+
+	return r.fileutil.WriteFileAtomic(r.todoTxtFilePath, []byte(file.String()), 0644)
+}
@@ -0,0 +1,165 @@
+package repository
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/yanosea/gct/app/config"
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+
+	"github.com/yanosea/gct/pkg/proxy"
+	"github.com/yanosea/gct/pkg/utility"
+)
+
+func newTestRepository(t *testing.T, content string) (todoDomain.TodoRepository, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "todo.txt")
+	os := proxy.NewOs()
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("cannot write %s: %v", path, err)
+	}
+	fileutil := utility.NewFileUtil(os, proxy.NewJson(), proxy.NewFlock())
+	repo, err := NewTodoRepository(&config.TodoConfig{TodoTxtFile: path, LockTimeout: time.Second}, fileutil, os)
+	if err != nil {
+		t.Fatalf("NewTodoRepository returned error: %v", err)
+	}
+	return repo, path
+}
+
+func readTestFile(t *testing.T, path string) string {
+	t.Helper()
+	file, err := proxy.NewOs().ReadFile(path)
+	if err != nil {
+		t.Fatalf("cannot read %s: %v", path, err)
+	}
+	return string(file)
+}
+
+func TestFindAllIsStable(t *testing.T) {
+	repo, _ := newTestRepository(t, "no creation date +home\n2026-10-01 dated\nx 2026-10-02 done without creation date\n")
+	first, err := repo.FindAll()
+	if err != nil {
+		t.Fatalf("FindAll returned error: %v", err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	second, err := repo.FindAll()
+	if err != nil {
+		t.Fatalf("FindAll returned error: %v", err)
+	}
+	for i := range first {
+		if first[i].ID != second[i].ID || !first[i].CreatedAt.Equal(second[i].CreatedAt) {
+			t.Errorf("line %d reads differently between runs: %+v, then %+v", i+1, first[i], second[i])
+		}
+		if !strings.HasPrefix(first[i].ID, "t") {
+			t.Errorf("derived id %q does not start with a letter", first[i].ID)
+		}
+	}
+	if !first[0].CreatedAt.IsZero() {
+		t.Errorf("line without a creation date has created time %s, want the zero time", first[0].CreatedAt)
+	}
+	if got := first[1].CreatedAt.Format(time.DateOnly); got != "2026-10-01" {
+		t.Errorf("creation date = %s, want 2026-10-01", got)
+	}
+	if got := first[2].CreatedAt.Format(time.DateOnly); got != "2026-10-02" {
+		t.Errorf("done line without a creation date has created time %s, want its completion date", got)
+	}
+}
+
+func TestModifyKeepsUntouchedLines(t *testing.T) {
+	untouched := []string{
+		"(B) 2026-10-01 call   mom @phone due:2026-10-20",
+		"x 2026-10-03 2026-10-01 pay rent pri:A",
+		"",
+		"water plants +home rec:weekly\r",
+		"# not a gct line, but a task to todo.txt",
+	}
+	content := strings.Join([]string{
+		untouched[0],
+		"(D) edit me +work later:yes",
+		untouched[1],
+		untouched[2],
+		"delete me",
+		untouched[3],
+		untouched[4],
+	}, "\n") + "\n"
+	repo, path := newTestRepository(t, content)
+
+	var added *todoDomain.Todo
+	if err := repo.Modify(func(todos []*todoDomain.Todo) ([]*todoDomain.Todo, error) {
+		kept := make([]*todoDomain.Todo, 0, len(todos))
+		for _, todo := range todos {
+			switch todo.Title {
+			case "edit me":
+				todo.Title = "edited"
+				todo.Notes = "see http://example.com/a b"
+			case "delete me":
+				continue
+			}
+			kept = append(kept, todo)
+		}
+		var err error
+		if added, err = todoDomain.NewTodo(todoDomain.NewShortIDGenerator(), "added"); err != nil {
+			return nil, err
+		}
+		return append(kept, added), nil
+	}); err != nil {
+		t.Fatalf("Modify returned error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(readTestFile(t, path), "\n"), "\n")
+	if len(lines) != 7 {
+		t.Fatalf("file has %d lines, want 7:\n%s", len(lines), strings.Join(lines, "\n"))
+	}
+	for i, want := range map[int]string{0: untouched[0], 2: untouched[1], 3: untouched[2], 4: untouched[3], 5: untouched[4]} {
+		if lines[i] != want {
+			t.Errorf("line %d = %q, want it untouched as %q", i+1, lines[i], want)
+		}
+	}
+	if edited := lines[1]; !strings.HasPrefix(edited, "(D) edited +work later:yes ") || !strings.Contains(edited, " id:") || !strings.Contains(edited, " note:") {
+		t.Errorf("edited line = %q, want its priority letter, word order, extras, id and note kept", edited)
+	}
+	if want := time.Now().Format(time.DateOnly) + " added id:" + added.ID; lines[6] != want {
+		t.Errorf("added line = %q, want %q", lines[6], want)
+	}
+
+	todos, err := repo.FindAll()
+	if err != nil {
+		t.Fatalf("FindAll returned error: %v", err)
+	}
+	for _, todo := range todos {
+		if todo.Title == "edited" && todo.Notes != "see http://example.com/a b" {
+			t.Errorf("notes read back as %q", todo.Notes)
+		}
+	}
+}
+
+func TestModifyLeavesTodosAsStored(t *testing.T) {
+	repo, _ := newTestRepository(t, "2026-10-01 write report\n")
+	var written []*todoDomain.Todo
+	if err := repo.Modify(func(todos []*todoDomain.Todo) ([]*todoDomain.Todo, error) {
+		now := time.Now()
+		if err := todos[0].SetStatus(todoDomain.StatusDone, now); err != nil {
+			return nil, err
+		}
+		todos[0].Trash(now)
+		added, err := todoDomain.NewTodo(todoDomain.NewShortIDGenerator(), "added")
+		if err != nil {
+			return nil, err
+		}
+		written = append(todos, added)
+		return written, nil
+	}); err != nil {
+		t.Fatalf("Modify returned error: %v", err)
+	}
+	read, err := repo.FindAll()
+	if err != nil {
+		t.Fatalf("FindAll returned error: %v", err)
+	}
+	for i := range read {
+		if !written[i].Equal(read[i]) {
+			t.Errorf("todo %d was written as %+v, but reads back as %+v", i+1, written[i], read[i])
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"maps"
+	"strings"
+	"time"
+
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+
+	"github.com/yanosea/gct/pkg/todotxt"
+)
+
+const (
+	deletedAtLayout = "2006-01-02T15:04:05"
+)
+
+// taskFromTodo lays the todo over the task it was read from so that whatever gct does not model is kept as written.
+func taskFromTodo(t *todoDomain.Todo, original *todotxt.Task) (*todotxt.Task, error) {
+	if err := todotxt.ValidateDescription(t.Title); err != nil {
+		return nil, fmt.Errorf("title cannot be stored in todo.txt: %w", err)
+	}
+	task := &todotxt.Task{CreationDate: t.CreatedAt.Format(todotxt.DateLayout)}
+	priority := todotxt.PriorityLetter(string(t.Priority))
+	if original != nil {
+		task = original.Clone()
+		// the letter is kept while it still reads as the same priority
+		if originalFields, _ := original.Fields(); todoDomain.Priority(todotxt.PriorityName(originalFields.Priority)) == t.Priority {
+			priority = originalFields.Priority
+		}
+	}
+	task.Done = t.IsClosed()
+	task.CompletionDate = ""
+	if task.Done && t.CompletedAt != nil {
+		task.CompletionDate = t.CompletedAt.Format(todotxt.DateLayout)
+	}
+	task.Description = t.Title
+	task.Extras = maps.Clone(t.Extras)
+	task.Projects, task.Contexts = todotxt.SplitTags(t.Tags)
+	fields := todotxt.Fields{
+		Priority:   priority,
+		Recurrence: string(t.Recurrence),
+		Notes:      t.Notes,
+		// lines gct writes carry their ID, since the one derived from the line would change with it
+		ID:        t.ID,
+		Parent:    t.ParentID,
+		BlockedBy: t.BlockedBy,
+	}
+	if t.Status != todoDomain.StatusTodo && t.Status != todoDomain.StatusDone {
+		fields.Status = string(t.Status)
+	}
+	if t.DueDate != nil {
+		fields.Due = t.DueDate.Format(todoDomain.DueDateLayout)
+	}
+	task.SetFields(fields)
+	if t.DeletedAt != nil {
+		task.Extras["deleted"] = t.DeletedAt.Format(deletedAtLayout)
+	}
+	return task, nil
+}
+
+func todoFromTask(task *todotxt.Task, line string, occurrence int, now time.Time) *todoDomain.Todo {
+	fields, extras := task.Fields()
+
+	// a line without a creation date keeps the zero time, so it reads the same on every run
+	t := &todoDomain.Todo{
+		ID:        fields.ID,
+		Title:     task.Description,
+		Status:    todoDomain.StatusTodo,
+		Priority:  todoDomain.Priority(todotxt.PriorityName(fields.Priority)),
+		Notes:     fields.Notes,
+		ParentID:  fields.Parent,
+		BlockedBy: fields.BlockedBy,
+	}
+	if t.Title == "" {
+		t.Title = strings.TrimSpace(line)
+	}
+	if t.ID == "" {
+		// a leading letter keeps derived IDs from being taken for list positions
+		t.ID = "t" + fmt.Sprintf("%x", sha1.Sum([]byte(fmt.Sprintf("%d:%s", occurrence, line))))[:7]
+	}
+	if created, err := time.ParseInLocation(todotxt.DateLayout, task.CreationDate, now.Location()); err == nil {
+		t.CreatedAt = created
+	}
+
+	if task.Done {
+		t.Status = todoDomain.StatusDone
+		if completed, err := time.ParseInLocation(todotxt.DateLayout, task.CompletionDate, now.Location()); err == nil {
+			t.CompletedAt = &completed
+			if task.CreationDate == "" {
+				t.CreatedAt = completed
+			}
+		}
+	}
+	// values gct cannot read are kept as they were written
+	if fields.Status != "" {
+		if status, err := todoDomain.ParseStatus(fields.Status); err == nil && status.IsClosed() == task.Done {
+			t.Status = status
+		} else {
+			extras["status"] = fields.Status
+		}
+	}
+
+	for _, tag := range task.Tags() {
+		t.Tags = appendTag(t.Tags, tag)
+	}
+	if fields.Due != "" {
+		if dueDate, err := time.ParseInLocation(todoDomain.DueDateLayout, fields.Due, now.Location()); err == nil {
+			t.DueDate = &dueDate
+		} else {
+			extras["due"] = fields.Due
+		}
+	}
+	if fields.Recurrence != "" {
+		if recurrence, err := todoDomain.ParseRecurrence(fields.Recurrence); err == nil {
+			t.Recurrence = recurrence
+		} else {
+			extras["rec"] = fields.Recurrence
+		}
+	}
+	if value, ok := extras["deleted"]; ok {
+		if deletedAt, err := time.ParseInLocation(deletedAtLayout, value, now.Location()); err == nil {
+			t.DeletedAt = &deletedAt
+			delete(extras, "deleted")
+		}
+	}
+	if len(extras) > 0 {
+		t.Extras = extras
+	}
+	return t
+}
+
+func appendTag(tags []string, tag string) []string {
+	if normalized, err := todoDomain.NormalizeTag(tag); err == nil {
+		tag = normalized
+	}
+	for _, existing := range tags {
+		if existing == tag {
+			return tags
+		}
+	}
+	return append(tags, tag)
+}
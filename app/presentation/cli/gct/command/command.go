@@ -17,7 +17,7 @@ var (
 )
 
 type Cli interface {
-	Init(envconfig proxy.Envconfig, json proxy.Json, os proxy.Os, fileUtil utility.FileUtil) int
+	Init(envconfig proxy.Envconfig, exec proxy.Exec, json proxy.Json, os proxy.Os, fileUtil utility.FileUtil) int
 	Run() int
 }
 
@@ -37,6 +37,7 @@ func newCli(cobra proxy.Cobra) Cli {
 
 func (c *cli) Init(
 	envconfig proxy.Envconfig,
+	exec proxy.Exec,
 	json proxy.Json,
 	os proxy.Os,
 	fileUtil utility.FileUtil,
@@ -51,6 +52,7 @@ func (c *cli) Init(
 
 	c.RootCommand = NewRootCommand(
 		c.Cobra,
+		exec,
 		json,
 		os,
 		fileUtil,
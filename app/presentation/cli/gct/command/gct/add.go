@@ -5,6 +5,7 @@ import (
 
 	todoApp "github.com/yanosea/gct/app/application/gct"
 	"github.com/yanosea/gct/app/config"
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
 	todoRepo "github.com/yanosea/gct/app/infrastructure/json/repository"
 	"github.com/yanosea/gct/app/presentation/cli/gct/formatter"
 
@@ -20,22 +21,72 @@ func NewAddCommand(
 	conf *config.TodoConfig,
 	output *string,
 ) proxy.Command {
-	var format = conf.OutputFormat
+	var notes string
+	var priority string
+	var dueDate string
+	var recurrence string
+	var tags []string
+	var parent string
 	cmd := cobra.NewCommand()
 	cmd.SetSilenceErrors(true)
 	cmd.SetUse("add [title]")
 	cmd.SetShort("Add a new todo")
 	cmd.SetArgs(cobra.ExactArgs(1))
+	format := newFormatOptions(cmd, conf, os)
 	cmd.PersistentFlags().StringVarP(
-		&format,
-		"format",
-		"f",
-		conf.OutputFormat,
-		"Output format (text|json)",
+		&notes,
+		"notes",
+		"n",
+		"",
+		"Notes of the todo",
+	)
+	cmd.PersistentFlags().StringVarP(
+		&priority,
+		"priority",
+		"p",
+		"",
+		"Priority (high|medium|low or 1-3)",
+	)
+	cmd.PersistentFlags().StringVarP(
+		&dueDate,
+		"due",
+		"d",
+		"",
+		"Due date (YYYY-MM-DD|today|tomorrow)",
+	)
+	cmd.PersistentFlags().StringVarP(
+		&recurrence,
+		"repeat",
+		"r",
+		"",
+		"Repeat rule (daily|weekly|weekly:mon,fri|monthly|monthly:D|every:N)",
+	)
+	cmd.PersistentFlags().StringArrayVarP(
+		&tags,
+		"tag",
+		"t",
+		nil,
+		"Tag to attach (repeatable, +tag in the title works too)",
+	)
+	cmd.PersistentFlags().StringVarP(
+		&parent,
+		"parent",
+		"",
+		"",
+		"ID, ID prefix or position of the parent todo to add a subtask to",
 	)
 	cmd.SetRunE(
 		func(cmd *c.Command, args []string) error {
-			return runAddCommand(args, format, json, os, fileutil, conf, output)
+			input := &todoApp.AddTodoUsecaseInputDto{
+				Title:      args[0],
+				Notes:      notes,
+				Priority:   priority,
+				DueDate:    dueDate,
+				Tags:       tags,
+				ParentID:   parent,
+				Recurrence: recurrence,
+			}
+			return runAddCommand(input, format, json, os, fileutil, conf, output)
 		},
 	)
 
@@ -43,15 +94,25 @@ func NewAddCommand(
 }
 
 func runAddCommand(
-	args []string,
-	format string,
+	input *todoApp.AddTodoUsecaseInputDto,
+	format *formatter.Options,
 	json proxy.Json,
 	os proxy.Os,
 	fileutil utility.FileUtil,
 	conf *config.TodoConfig,
 	output *string,
 ) error {
-	todoRepo, err := todoRepo.NewTodoRepository(
+	journalRepo, err := todoRepo.NewJournalRepository(
+		conf,
+		fileutil,
+		json,
+		os,
+	)
+	if err != nil {
+		return err
+	}
+
+	todoRepo, err := newTodoRepository(
 		conf,
 		fileutil,
 		json,
@@ -61,8 +122,18 @@ func runAddCommand(
 		return err
 	}
 
-	uc := todoApp.NewAddTodoUseCase(todoRepo)
-	dto, err := uc.Run(args[0])
+	idGenerator, err := todoDomain.NewIDGenerator(conf.IDFormat)
+	if err != nil {
+		return err
+	}
+
+	listOrder, err := todoDomain.ParseSortSpec(conf.DefaultSort)
+	if err != nil {
+		return err
+	}
+
+	uc := todoApp.NewAddTodoUseCase(todoRepo, journalRepo, idGenerator, listOrder)
+	dto, err := uc.Run(input)
 	if err != nil {
 		return err
 	}
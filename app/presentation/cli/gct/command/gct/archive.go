@@ -0,0 +1,106 @@
+package gct
+
+import (
+	c "github.com/spf13/cobra"
+
+	todoApp "github.com/yanosea/gct/app/application/gct"
+	"github.com/yanosea/gct/app/config"
+	todoRepo "github.com/yanosea/gct/app/infrastructure/json/repository"
+	"github.com/yanosea/gct/app/presentation/cli/gct/formatter"
+
+	"github.com/yanosea/gct/pkg/proxy"
+	"github.com/yanosea/gct/pkg/utility"
+)
+
+func NewArchiveCommand(
+	cobra proxy.Cobra,
+	json proxy.Json,
+	os proxy.Os,
+	fileutil utility.FileUtil,
+	conf *config.TodoConfig,
+	output *string,
+) proxy.Command {
+	var olderThan string
+	cmd := cobra.NewCommand()
+	cmd.SetSilenceErrors(true)
+	cmd.SetUse("archive")
+	cmd.SetShort("Move completed todos into monthly archive files")
+	cmd.SetArgs(cobra.ExactArgs(0))
+	format := newFormatOptions(cmd, conf, os)
+	cmd.PersistentFlags().StringVarP(
+		&olderThan,
+		"older-than",
+		"o",
+		"30d",
+		"Only archive todos completed longer ago than this (e.g. 30d, 2w, 0s)",
+	)
+	cmd.SetRunE(
+		func(_ *c.Command, _ []string) error {
+			return runArchive(olderThan, format, json, os, fileutil, conf, output)
+		},
+	)
+
+	return cmd
+}
+
+func runArchive(
+	olderThan string,
+	format *formatter.Options,
+	json proxy.Json,
+	os proxy.Os,
+	fileutil utility.FileUtil,
+	conf *config.TodoConfig,
+	output *string,
+) error {
+	archiveRepo, err := todoRepo.NewArchiveRepository(
+		conf,
+		fileutil,
+		json,
+		os,
+	)
+	if err != nil {
+		return err
+	}
+
+	journalRepo, err := todoRepo.NewJournalRepository(
+		conf,
+		fileutil,
+		json,
+		os,
+	)
+	if err != nil {
+		return err
+	}
+
+	todoRepo, err := newTodoRepository(
+		conf,
+		fileutil,
+		json,
+		os,
+	)
+	if err != nil {
+		return err
+	}
+
+	uc := todoApp.NewArchiveTodoUseCase(todoRepo, archiveRepo, journalRepo)
+	dto, err := uc.Run(&todoApp.ArchiveTodoUsecaseInputDto{
+		OlderThan: olderThan,
+	})
+	if err != nil {
+		return err
+	}
+
+	f, err := formatter.NewFormatter(format, json)
+	if err != nil {
+		return err
+	}
+
+	o, err := f.Format(dto)
+	if err != nil {
+		return err
+	}
+
+	*output = o
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package gct
+
+import (
+	c "github.com/spf13/cobra"
+
+	todoApp "github.com/yanosea/gct/app/application/gct"
+	"github.com/yanosea/gct/app/config"
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+	todoRepo "github.com/yanosea/gct/app/infrastructure/json/repository"
+	"github.com/yanosea/gct/app/presentation/cli/gct/formatter"
+
+	"github.com/yanosea/gct/pkg/proxy"
+	"github.com/yanosea/gct/pkg/utility"
+)
+
+func NewBlockCommand(
+	cobra proxy.Cobra,
+	json proxy.Json,
+	os proxy.Os,
+	fileutil utility.FileUtil,
+	conf *config.TodoConfig,
+	output *string,
+) proxy.Command {
+	var blocker string
+	cmd := cobra.NewCommand()
+	cmd.SetSilenceErrors(true)
+	cmd.SetUse("block [id]")
+	cmd.SetShort("Mark a todo as blocked by another todo")
+	cmd.SetArgs(cobra.ExactArgs(1))
+	format := newFormatOptions(cmd, conf, os)
+	cmd.PersistentFlags().StringVarP(
+		&blocker,
+		"on",
+		"o",
+		"",
+		"ID, ID prefix or position of the todo that has to be completed first",
+	)
+	cmd.MarkPersistentFlagRequired("on")
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			input := &todoApp.BlockTodoUsecaseInputDto{
+				ID:        args[0],
+				BlockerID: blocker,
+			}
+			return runBlock(input, format, json, os, fileutil, conf, output)
+		},
+	)
+
+	return cmd
+}
+
+func runBlock(
+	input *todoApp.BlockTodoUsecaseInputDto,
+	format *formatter.Options,
+	json proxy.Json,
+	os proxy.Os,
+	fileutil utility.FileUtil,
+	conf *config.TodoConfig,
+	output *string,
+) error {
+	journalRepo, err := todoRepo.NewJournalRepository(
+		conf,
+		fileutil,
+		json,
+		os,
+	)
+	if err != nil {
+		return err
+	}
+
+	todoRepo, err := newTodoRepository(
+		conf,
+		fileutil,
+		json,
+		os,
+	)
+	if err != nil {
+		return err
+	}
+
+	listOrder, err := todoDomain.ParseSortSpec(conf.DefaultSort)
+	if err != nil {
+		return err
+	}
+
+	uc := todoApp.NewBlockTodoUseCase(todoRepo, journalRepo, listOrder)
+	dto, err := uc.Run(input)
+	if err != nil {
+		return err
+	}
+
+	f, err := formatter.NewFormatter(format, json)
+	if err != nil {
+		return err
+	}
+
+	o, err := f.Format(dto)
+	if err != nil {
+		return err
+	}
+
+	*output = o
+
+	return nil
+}
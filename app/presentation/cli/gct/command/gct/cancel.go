@@ -0,0 +1,101 @@
+package gct
+
+import (
+	c "github.com/spf13/cobra"
+
+	todoApp "github.com/yanosea/gct/app/application/gct"
+	"github.com/yanosea/gct/app/config"
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+	todoRepo "github.com/yanosea/gct/app/infrastructure/json/repository"
+	"github.com/yanosea/gct/app/presentation/cli/gct/formatter"
+
+	"github.com/yanosea/gct/pkg/proxy"
+	"github.com/yanosea/gct/pkg/utility"
+)
+
+func NewCancelCommand(
+	cobra proxy.Cobra,
+	json proxy.Json,
+	os proxy.Os,
+	fileutil utility.FileUtil,
+	conf *config.TodoConfig,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetSilenceErrors(true)
+	cmd.SetUse("cancel [id]")
+	cmd.SetShort("Cancel a todo")
+	cmd.SetArgs(cobra.ExactArgs(1))
+	format := newFormatOptions(cmd, conf, os)
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			input := &todoApp.ChangeTodoStatusUsecaseInputDto{
+				ID:     args[0],
+				Status: "cancelled",
+			}
+			return runCancel(input, format, json, os, fileutil, conf, output)
+		},
+	)
+
+	return cmd
+}
+
+func runCancel(
+	input *todoApp.ChangeTodoStatusUsecaseInputDto,
+	format *formatter.Options,
+	json proxy.Json,
+	os proxy.Os,
+	fileutil utility.FileUtil,
+	conf *config.TodoConfig,
+	output *string,
+) error {
+	journalRepo, err := todoRepo.NewJournalRepository(
+		conf,
+		fileutil,
+		json,
+		os,
+	)
+	if err != nil {
+		return err
+	}
+
+	todoRepo, err := newTodoRepository(
+		conf,
+		fileutil,
+		json,
+		os,
+	)
+	if err != nil {
+		return err
+	}
+
+	idGenerator, err := todoDomain.NewIDGenerator(conf.IDFormat)
+	if err != nil {
+		return err
+	}
+
+	listOrder, err := todoDomain.ParseSortSpec(conf.DefaultSort)
+	if err != nil {
+		return err
+	}
+
+	uc := todoApp.NewChangeTodoStatusUseCase(todoRepo, journalRepo, idGenerator, listOrder)
+	dto, err := uc.Run(input)
+	if err != nil {
+		return err
+	}
+
+	f, err := formatter.NewFormatter(format, json)
+	if err != nil {
+		return err
+	}
+
+	o, err := f.Format(dto)
+	if err != nil {
+		return err
+	}
+
+	*output = o
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package gct
+
+import (
+	"github.com/yanosea/gct/app/config"
+
+	"github.com/yanosea/gct/pkg/proxy"
+	"github.com/yanosea/gct/pkg/utility"
+)
+
+func NewDbCommand(
+	cobra proxy.Cobra,
+	json proxy.Json,
+	os proxy.Os,
+	fileutil utility.FileUtil,
+	conf *config.TodoConfig,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetSilenceErrors(true)
+	cmd.SetUse("db")
+	cmd.SetShort("Manage the todo database")
+	cmd.AddCommand(
+		NewDbMigrateCommand(
+			cobra,
+			json,
+			os,
+			fileutil,
+			conf,
+			output,
+		),
+	)
+
+	return cmd
+}
@@ -0,0 +1,84 @@
+package gct
+
+import (
+	c "github.com/spf13/cobra"
+
+	todoApp "github.com/yanosea/gct/app/application/gct"
+	"github.com/yanosea/gct/app/config"
+	todoRepo "github.com/yanosea/gct/app/infrastructure/json/repository"
+	"github.com/yanosea/gct/app/presentation/cli/gct/formatter"
+
+	"github.com/yanosea/gct/pkg/proxy"
+	"github.com/yanosea/gct/pkg/utility"
+)
+
+func NewDbMigrateCommand(
+	cobra proxy.Cobra,
+	json proxy.Json,
+	os proxy.Os,
+	fileutil utility.FileUtil,
+	conf *config.TodoConfig,
+	output *string,
+) proxy.Command {
+	var dryRun bool
+	cmd := cobra.NewCommand()
+	cmd.SetSilenceErrors(true)
+	cmd.SetUse("migrate")
+	cmd.SetShort("Upgrade the database to the latest schema version")
+	cmd.SetArgs(cobra.ExactArgs(0))
+	format := newFormatOptions(cmd, conf, os)
+	cmd.PersistentFlags().BoolVarP(
+		&dryRun,
+		"dry-run",
+		"n",
+		false,
+		"Show pending migrations without writing anything",
+	)
+	cmd.SetRunE(
+		func(_ *c.Command, _ []string) error {
+			return runDbMigrate(dryRun, format, json, os, fileutil, conf, output)
+		},
+	)
+
+	return cmd
+}
+
+func runDbMigrate(
+	dryRun bool,
+	format *formatter.Options,
+	json proxy.Json,
+	os proxy.Os,
+	fileutil utility.FileUtil,
+	conf *config.TodoConfig,
+	output *string,
+) error {
+	todoMigrator, err := todoRepo.NewTodoMigrator(
+		conf,
+		fileutil,
+		json,
+		os,
+	)
+	if err != nil {
+		return err
+	}
+
+	uc := todoApp.NewMigrateDatabaseUseCase(todoMigrator)
+	dto, err := uc.Run(dryRun)
+	if err != nil {
+		return err
+	}
+
+	f, err := formatter.NewFormatter(format, json)
+	if err != nil {
+		return err
+	}
+
+	o, err := f.Format(dto)
+	if err != nil {
+		return err
+	}
+
+	*output = o
+
+	return nil
+}
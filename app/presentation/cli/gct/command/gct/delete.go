@@ -5,6 +5,7 @@ import (
 
 	todoApp "github.com/yanosea/gct/app/application/gct"
 	"github.com/yanosea/gct/app/config"
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
 	todoRepo "github.com/yanosea/gct/app/infrastructure/json/repository"
 	"github.com/yanosea/gct/app/presentation/cli/gct/formatter"
 
@@ -20,19 +21,12 @@ func NewDeleteCommand(
 	conf *config.TodoConfig,
 	output *string,
 ) proxy.Command {
-	var format = conf.OutputFormat
 	cmd := cobra.NewCommand()
 	cmd.SetSilenceErrors(true)
 	cmd.SetUse("delete [id]")
-	cmd.SetShort("Delete a todo")
+	cmd.SetShort("Move a todo to the trash")
 	cmd.SetArgs(cobra.ExactArgs(1))
-	cmd.PersistentFlags().StringVarP(
-		&format,
-		"format",
-		"f",
-		conf.OutputFormat,
-		"Output format (text|json)",
-	)
+	format := newFormatOptions(cmd, conf, os)
 	cmd.SetRunE(
 		func(cmd *c.Command, args []string) error {
 			return runDelete(args, format, json, os, fileutil, conf, output)
@@ -44,14 +38,24 @@ func NewDeleteCommand(
 
 func runDelete(
 	args []string,
-	format string,
+	format *formatter.Options,
 	json proxy.Json,
 	os proxy.Os,
 	fileutil utility.FileUtil,
 	conf *config.TodoConfig,
 	output *string,
 ) error {
-	todoRepo, err := todoRepo.NewTodoRepository(
+	journalRepo, err := todoRepo.NewJournalRepository(
+		conf,
+		fileutil,
+		json,
+		os,
+	)
+	if err != nil {
+		return err
+	}
+
+	todoRepo, err := newTodoRepository(
 		conf,
 		fileutil,
 		json,
@@ -61,7 +65,12 @@ func runDelete(
 		return err
 	}
 
-	uc := todoApp.NewDeleteTodoUseCase(todoRepo)
+	listOrder, err := todoDomain.ParseSortSpec(conf.DefaultSort)
+	if err != nil {
+		return err
+	}
+
+	uc := todoApp.NewDeleteTodoUseCase(todoRepo, journalRepo, listOrder)
 	dto, err := uc.Run(args[0])
 	if err != nil {
 		return err
@@ -0,0 +1,297 @@
+package gct
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	c "github.com/spf13/cobra"
+
+	todoApp "github.com/yanosea/gct/app/application/gct"
+	"github.com/yanosea/gct/app/config"
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+	todoRepo "github.com/yanosea/gct/app/infrastructure/json/repository"
+	"github.com/yanosea/gct/app/presentation/cli/gct/formatter"
+
+	"github.com/yanosea/gct/pkg/proxy"
+	"github.com/yanosea/gct/pkg/utility"
+)
+
+func NewEditCommand(
+	cobra proxy.Cobra,
+	exec proxy.Exec,
+	json proxy.Json,
+	os proxy.Os,
+	fileutil utility.FileUtil,
+	conf *config.TodoConfig,
+	output *string,
+) proxy.Command {
+	var notes string
+	var priority string
+	var dueDate string
+	var recurrence string
+	var tags []string
+	var clearTags bool
+	var useEditor bool
+	cmd := cobra.NewCommand()
+	cmd.SetSilenceErrors(true)
+	cmd.SetUse("edit [id] [title]")
+	cmd.SetShort("Edit a todo")
+	cmd.SetArgs(cobra.RangeArgs(1, 2))
+	format := newFormatOptions(cmd, conf, os)
+	cmd.PersistentFlags().StringVarP(
+		&notes,
+		"notes",
+		"n",
+		"",
+		"Notes of the todo",
+	)
+	cmd.PersistentFlags().StringVarP(
+		&priority,
+		"priority",
+		"p",
+		"",
+		"Priority (high|medium|low|none or 1-3)",
+	)
+	cmd.PersistentFlags().StringVarP(
+		&dueDate,
+		"due",
+		"d",
+		"",
+		"Due date (YYYY-MM-DD|today|tomorrow|none)",
+	)
+	cmd.PersistentFlags().StringVarP(
+		&recurrence,
+		"repeat",
+		"r",
+		"",
+		"Repeat rule (daily|weekly|weekly:mon,fri|monthly|monthly:D|every:N|none)",
+	)
+	cmd.PersistentFlags().StringArrayVarP(
+		&tags,
+		"tag",
+		"t",
+		nil,
+		"Replace the tags of the todo (repeatable)",
+	)
+	cmd.PersistentFlags().BoolVarP(
+		&clearTags,
+		"clear-tags",
+		"",
+		false,
+		"Remove all tags from the todo",
+	)
+	cmd.PersistentFlags().BoolVarP(
+		&useEditor,
+		"editor",
+		"e",
+		false,
+		"Edit the title and notes in $VISUAL or $EDITOR",
+	)
+	cmd.SetRunE(
+		func(cmd *c.Command, args []string) error {
+			input := &todoApp.EditTodoUsecaseInputDto{
+				ID: args[0],
+			}
+			if len(args) > 1 {
+				input.Title = &args[1]
+			}
+			if cmd.Flags().Changed("notes") {
+				input.Notes = &notes
+			}
+			if cmd.Flags().Changed("priority") {
+				input.Priority = &priority
+			}
+			if cmd.Flags().Changed("due") {
+				input.DueDate = &dueDate
+			}
+			if cmd.Flags().Changed("repeat") {
+				input.Recurrence = &recurrence
+			}
+			if cmd.Flags().Changed("tag") || clearTags {
+				input.Tags = &tags
+			}
+			if useEditor {
+				if input.Title != nil || input.Notes != nil || input.Tags != nil {
+					return errors.New("--editor cannot be combined with a title, --notes or tag flags")
+				}
+				return runEditInEditor(cmd, input, format, exec, json, os, fileutil, conf, output)
+			}
+			return runEdit(input, format, json, os, fileutil, conf, output)
+		},
+	)
+
+	return cmd
+}
+
+func runEdit(
+	input *todoApp.EditTodoUsecaseInputDto,
+	format *formatter.Options,
+	json proxy.Json,
+	os proxy.Os,
+	fileutil utility.FileUtil,
+	conf *config.TodoConfig,
+	output *string,
+) error {
+	journalRepo, err := todoRepo.NewJournalRepository(
+		conf,
+		fileutil,
+		json,
+		os,
+	)
+	if err != nil {
+		return err
+	}
+
+	todoRepo, err := newTodoRepository(
+		conf,
+		fileutil,
+		json,
+		os,
+	)
+	if err != nil {
+		return err
+	}
+
+	listOrder, err := todoDomain.ParseSortSpec(conf.DefaultSort)
+	if err != nil {
+		return err
+	}
+
+	uc := todoApp.NewEditTodoUseCase(todoRepo, journalRepo, listOrder)
+	dto, err := uc.Run(input)
+	if err != nil {
+		return err
+	}
+
+	f, err := formatter.NewFormatter(format, json)
+	if err != nil {
+		return err
+	}
+
+	o, err := f.Format(dto)
+	if err != nil {
+		return err
+	}
+
+	*output = o
+
+	return nil
+}
+
+func runEditInEditor(
+	cmd *c.Command,
+	input *todoApp.EditTodoUsecaseInputDto,
+	format *formatter.Options,
+	exec proxy.Exec,
+	json proxy.Json,
+	os proxy.Os,
+	fileutil utility.FileUtil,
+	conf *config.TodoConfig,
+	output *string,
+) error {
+	todoRepo, err := newTodoRepository(
+		conf,
+		fileutil,
+		json,
+		os,
+	)
+	if err != nil {
+		return err
+	}
+
+	listOrder, err := todoDomain.ParseSortSpec(conf.DefaultSort)
+	if err != nil {
+		return err
+	}
+
+	current, err := todoApp.NewGetTodoUseCase(todoRepo, listOrder).Run(input.ID)
+	if err != nil {
+		return err
+	}
+
+	content, err := editInEditor(
+		formatEditorContent(current.Title, current.Tags, current.Notes),
+		cmd.InOrStdin(),
+		cmd.OutOrStdout(),
+		cmd.ErrOrStderr(),
+		exec,
+		os,
+	)
+	if err != nil {
+		return err
+	}
+
+	title, notes := parseEditorContent(content)
+	tags := []string{}
+	input.ID = current.ID
+	input.Title = &title
+	input.Notes = &notes
+	input.Tags = &tags
+
+	return runEdit(input, format, json, os, fileutil, conf, output)
+}
+
+func editInEditor(
+	content string,
+	stdin io.Reader,
+	stdout io.Writer,
+	stderr io.Writer,
+	exec proxy.Exec,
+	os proxy.Os,
+) (string, error) {
+	editor := strings.Fields(os.Getenv("VISUAL"))
+	if len(editor) == 0 {
+		editor = strings.Fields(os.Getenv("EDITOR"))
+	}
+	if len(editor) == 0 {
+		editor = []string{"vi"}
+	}
+
+	file, err := os.CreateTemp("", "gct-*.md")
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = os.Remove(file.Name())
+	}()
+	if _, err := file.Write([]byte(content)); err != nil {
+		_ = file.Close()
+		return "", err
+	}
+	if err := file.Close(); err != nil {
+		return "", err
+	}
+
+	editorCmd := exec.Command(editor[0], append(editor[1:], file.Name())...)
+	editorCmd.SetStdin(stdin)
+	editorCmd.SetStdout(stdout)
+	editorCmd.SetStderr(stderr)
+	if err := editorCmd.Run(); err != nil {
+		return "", err
+	}
+
+	edited, err := os.ReadFile(file.Name())
+	if err != nil {
+		return "", err
+	}
+
+	return string(edited), nil
+}
+
+func formatEditorContent(title string, tags []string, notes string) string {
+	line := title
+	for _, tag := range tags {
+		line += " +" + tag
+	}
+	if notes == "" {
+		return line + "\n"
+	}
+	return line + "\n\n" + notes + "\n"
+}
+
+func parseEditorContent(content string) (string, string) {
+	content = strings.TrimLeft(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	title, notes, _ := strings.Cut(content, "\n")
+	return strings.TrimSpace(title), strings.Trim(notes, "\n")
+}
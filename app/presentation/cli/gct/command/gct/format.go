@@ -0,0 +1,88 @@
+package gct
+
+import (
+	"strings"
+
+	"github.com/yanosea/gct/app/config"
+	"github.com/yanosea/gct/app/presentation/cli/gct/formatter"
+
+	"github.com/yanosea/gct/pkg/proxy"
+)
+
+func newFormatOptions(
+	cmd proxy.Command,
+	conf *config.TodoConfig,
+	os proxy.Os,
+) *formatter.Options {
+	options := &formatter.Options{
+		Format: conf.OutputFormat,
+		Width:  os.TerminalWidth(),
+	}
+	cmd.PersistentFlags().StringVarP(
+		&options.Format,
+		"format",
+		"f",
+		conf.OutputFormat,
+		"Output format (text|json|table|csv|tsv|template|markdown|todotxt)",
+	)
+	cmd.PersistentFlags().StringSliceVarP(
+		&options.Columns,
+		"columns",
+		"",
+		nil,
+		"Columns of the table, csv and tsv formats, in order (e.g. id,status,title,due)",
+	)
+	cmd.PersistentFlags().BoolVarP(
+		&options.NoHeaders,
+		"no-headers",
+		"",
+		false,
+		"Omit the header row of the table, csv and tsv formats",
+	)
+	cmd.PersistentFlags().VarP(
+		&templateFlag{
+			template: &options.Template,
+			load: func(value string) (string, error) {
+				return templateEscapes.Replace(value), nil
+			},
+		},
+		"template",
+		"",
+		"Go template of the template format, applied to each item (e.g. '{{.ID}}\\t{{.Title}}')",
+	)
+	cmd.PersistentFlags().VarP(
+		&templateFlag{
+			template: &options.Template,
+			load: func(value string) (string, error) {
+				data, err := os.ReadFile(value)
+				return string(data), err
+			},
+		},
+		"template-file",
+		"",
+		"File containing the Go template of the template format",
+	)
+	return options
+}
+
+var templateEscapes = strings.NewReplacer(`\\`, `\`, `\t`, "\t", `\n`, "\n")
+
+type templateFlag struct {
+	template *string
+	value    string
+	load     func(value string) (string, error)
+}
+
+func (f *templateFlag) Set(value string) error {
+	template, err := f.load(value)
+	if err != nil {
+		return err
+	}
+	f.value = value
+	*f.template = template
+	return nil
+}
+
+func (f *templateFlag) String() string { return f.value }
+
+func (f *templateFlag) Type() string { return "string" }
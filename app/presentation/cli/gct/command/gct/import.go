@@ -0,0 +1,123 @@
+package gct
+
+import (
+	c "github.com/spf13/cobra"
+
+	todoApp "github.com/yanosea/gct/app/application/gct"
+	"github.com/yanosea/gct/app/config"
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+	todoRepo "github.com/yanosea/gct/app/infrastructure/json/repository"
+	"github.com/yanosea/gct/app/presentation/cli/gct/formatter"
+	"github.com/yanosea/gct/app/presentation/cli/gct/importer"
+
+	"github.com/yanosea/gct/pkg/proxy"
+	"github.com/yanosea/gct/pkg/utility"
+)
+
+func NewImportCommand(
+	cobra proxy.Cobra,
+	json proxy.Json,
+	os proxy.Os,
+	fileutil utility.FileUtil,
+	conf *config.TodoConfig,
+	output *string,
+) proxy.Command {
+	var importFormat string
+	cmd := cobra.NewCommand()
+	cmd.SetSilenceErrors(true)
+	cmd.SetUse("import [file]")
+	cmd.SetShort("Import todos from a file")
+	cmd.SetArgs(cobra.ExactArgs(1))
+	cmd.PersistentFlags().StringVarP(
+		&importFormat,
+		"format",
+		"f",
+		"",
+		"Format of the file (csv|tsv|markdown|todotxt, defaults to the file extension)",
+	)
+	cmd.SetRunE(
+		func(_ *c.Command, args []string) error {
+			return runImport(args[0], importFormat, json, os, fileutil, conf, output)
+		},
+	)
+
+	return cmd
+}
+
+func runImport(
+	path string,
+	importFormat string,
+	json proxy.Json,
+	os proxy.Os,
+	fileutil utility.FileUtil,
+	conf *config.TodoConfig,
+	output *string,
+) error {
+	i, err := importer.NewImporter(importFormat, path)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	input, err := i.Import(data)
+	if err != nil {
+		return err
+	}
+
+	journalRepo, err := todoRepo.NewJournalRepository(
+		conf,
+		fileutil,
+		json,
+		os,
+	)
+	if err != nil {
+		return err
+	}
+
+	todoRepo, err := newTodoRepository(
+		conf,
+		fileutil,
+		json,
+		os,
+	)
+	if err != nil {
+		return err
+	}
+
+	idGenerator, err := todoDomain.NewIDGenerator(conf.IDFormat)
+	if err != nil {
+		return err
+	}
+
+	listOrder, err := todoDomain.ParseSortSpec(conf.DefaultSort)
+	if err != nil {
+		return err
+	}
+
+	uc := todoApp.NewImportTodoUseCase(todoRepo, journalRepo, idGenerator, listOrder)
+	dto, err := uc.Run(input)
+	if err != nil {
+		return err
+	}
+
+	f, err := formatter.NewFormatter(&formatter.Options{
+		Format: conf.OutputFormat,
+		Width:  os.TerminalWidth(),
+	}, json)
+	if err != nil {
+		return err
+	}
+
+	o, err := f.Format(dto)
+	if err != nil {
+		return err
+	}
+
+	*output = o
+
+	return nil
+}
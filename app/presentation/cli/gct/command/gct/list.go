@@ -1,10 +1,13 @@
 package gct
 
 import (
+	"strings"
+
 	c "github.com/spf13/cobra"
 
 	todoApp "github.com/yanosea/gct/app/application/gct"
 	"github.com/yanosea/gct/app/config"
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
 	todoRepo "github.com/yanosea/gct/app/infrastructure/json/repository"
 	"github.com/yanosea/gct/app/presentation/cli/gct/formatter"
 
@@ -20,21 +23,73 @@ func NewListCommand(
 	conf *config.TodoConfig,
 	output *string,
 ) proxy.Command {
-	var format = conf.OutputFormat
+	var sort = conf.DefaultSort
+	var byPriority bool
+	var tags []string
+	var matchAnyTag bool
+	var includeArchived bool
+	var tree bool
 	cmd := cobra.NewCommand()
 	cmd.SetSilenceErrors(true)
-	cmd.SetUse("list")
+	cmd.SetUse("list [query]")
 	cmd.SetShort("List all todos")
+	format := newFormatOptions(cmd, conf, os)
 	cmd.PersistentFlags().StringVarP(
-		&format,
-		"format",
-		"f",
-		conf.OutputFormat,
-		"Output format (text|json)",
+		&sort,
+		"sort",
+		"s",
+		conf.DefaultSort,
+		"Sort keys such as created:desc,title (completed|created|done|due|priority|status|title)",
+	)
+	cmd.PersistentFlags().BoolVarP(
+		&byPriority,
+		"by-priority",
+		"p",
+		false,
+		"Order todos by priority, then by creation time (same as --sort priority:desc,created)",
+	)
+	cmd.PersistentFlags().StringArrayVarP(
+		&tags,
+		"tag",
+		"t",
+		nil,
+		"Show only todos with the tag (repeatable)",
+	)
+	cmd.PersistentFlags().BoolVarP(
+		&matchAnyTag,
+		"any",
+		"",
+		false,
+		"Match todos having any of the tags instead of all of them",
+	)
+	cmd.PersistentFlags().BoolVarP(
+		&includeArchived,
+		"include-archived",
+		"a",
+		false,
+		"Also show archived todos",
+	)
+	cmd.PersistentFlags().BoolVarP(
+		&tree,
+		"tree",
+		"",
+		false,
+		"Show subtasks indented under their parent todo",
 	)
 	cmd.SetRunE(
-		func(_ *c.Command, _ []string) error {
-			return runList(format, json, os, fileutil, conf, output)
+		func(_ *c.Command, args []string) error {
+			if byPriority {
+				sort = "priority:desc,created"
+			}
+			input := &todoApp.ListTodoUsecaseInputDto{
+				Sort:            sort,
+				Tags:            tags,
+				MatchAnyTag:     matchAnyTag,
+				IncludeArchived: includeArchived,
+				Tree:            tree || format.Format == "markdown",
+				Query:           strings.Join(args, " "),
+			}
+			return runList(input, format, json, os, fileutil, conf, output)
 		},
 	)
 
@@ -42,14 +97,15 @@ func NewListCommand(
 }
 
 func runList(
-	format string,
+	input *todoApp.ListTodoUsecaseInputDto,
+	format *formatter.Options,
 	json proxy.Json,
 	os proxy.Os,
 	fileutil utility.FileUtil,
 	conf *config.TodoConfig,
 	output *string,
 ) error {
-	todoRepo, err := todoRepo.NewTodoRepository(
+	archiveRepo, err := todoRepo.NewArchiveRepository(
 		conf,
 		fileutil,
 		json,
@@ -59,8 +115,23 @@ func runList(
 		return err
 	}
 
-	uc := todoApp.NewListTodoUseCase(todoRepo)
-	dto, err := uc.Run()
+	todoRepo, err := newTodoRepository(
+		conf,
+		fileutil,
+		json,
+		os,
+	)
+	if err != nil {
+		return err
+	}
+
+	listOrder, err := todoDomain.ParseSortSpec(conf.DefaultSort)
+	if err != nil {
+		return err
+	}
+
+	uc := todoApp.NewListTodoUseCase(todoRepo, archiveRepo, listOrder)
+	dto, err := uc.Run(input)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,23 @@
+package gct
+
+import (
+	"github.com/yanosea/gct/app/config"
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+	todoRepo "github.com/yanosea/gct/app/infrastructure/json/repository"
+	todoTxtRepo "github.com/yanosea/gct/app/infrastructure/todotxt/repository"
+
+	"github.com/yanosea/gct/pkg/proxy"
+	"github.com/yanosea/gct/pkg/utility"
+)
+
+func newTodoRepository(
+	conf *config.TodoConfig,
+	fileutil utility.FileUtil,
+	json proxy.Json,
+	os proxy.Os,
+) (todoDomain.TodoRepository, error) {
+	if conf.TodoTxtFile != "" {
+		return todoTxtRepo.NewTodoRepository(conf, fileutil, os)
+	}
+	return todoRepo.NewTodoRepository(conf, fileutil, json, os)
+}
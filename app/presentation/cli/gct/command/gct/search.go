@@ -0,0 +1,86 @@
+package gct
+
+import (
+	"strings"
+
+	c "github.com/spf13/cobra"
+
+	todoApp "github.com/yanosea/gct/app/application/gct"
+	"github.com/yanosea/gct/app/config"
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
+	"github.com/yanosea/gct/app/presentation/cli/gct/formatter"
+
+	"github.com/yanosea/gct/pkg/proxy"
+	"github.com/yanosea/gct/pkg/utility"
+)
+
+func NewSearchCommand(
+	cobra proxy.Cobra,
+	json proxy.Json,
+	os proxy.Os,
+	fileutil utility.FileUtil,
+	conf *config.TodoConfig,
+	output *string,
+) proxy.Command {
+	cmd := cobra.NewCommand()
+	cmd.SetSilenceErrors(true)
+	cmd.SetUse("search [query]")
+	cmd.SetShort("Search todos by title and notes")
+	cmd.SetArgs(cobra.MinimumNArgs(1))
+	format := newFormatOptions(cmd, conf, os)
+	cmd.SetRunE(
+		func(_ *c.Command, args []string) error {
+			input := &todoApp.SearchTodoUsecaseInputDto{
+				Query: strings.Join(args, " "),
+			}
+			return runSearch(input, format, json, os, fileutil, conf, output)
+		},
+	)
+
+	return cmd
+}
+
+func runSearch(
+	input *todoApp.SearchTodoUsecaseInputDto,
+	format *formatter.Options,
+	json proxy.Json,
+	os proxy.Os,
+	fileutil utility.FileUtil,
+	conf *config.TodoConfig,
+	output *string,
+) error {
+	todoRepo, err := newTodoRepository(
+		conf,
+		fileutil,
+		json,
+		os,
+	)
+	if err != nil {
+		return err
+	}
+
+	listOrder, err := todoDomain.ParseSortSpec(conf.DefaultSort)
+	if err != nil {
+		return err
+	}
+
+	uc := todoApp.NewSearchTodoUseCase(todoRepo, listOrder)
+	dto, err := uc.Run(input)
+	if err != nil {
+		return err
+	}
+
+	f, err := formatter.NewFormatter(format, json)
+	if err != nil {
+		return err
+	}
+
+	o, err := f.Format(dto)
+	if err != nil {
+		return err
+	}
+
+	*output = o
+
+	return nil
+}
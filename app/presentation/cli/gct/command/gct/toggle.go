@@ -5,6 +5,7 @@ import (
 
 	todoApp "github.com/yanosea/gct/app/application/gct"
 	"github.com/yanosea/gct/app/config"
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
 	todoRepo "github.com/yanosea/gct/app/infrastructure/json/repository"
 	"github.com/yanosea/gct/app/presentation/cli/gct/formatter"
 
@@ -20,22 +21,27 @@ func NewToggleCommand(
 	conf *config.TodoConfig,
 	output *string,
 ) proxy.Command {
-	var format = conf.OutputFormat
+	var force bool
 	cmd := cobra.NewCommand()
 	cmd.SetSilenceErrors(true)
 	cmd.SetUse("toggle [id]")
 	cmd.SetShort("Toggle todo status")
 	cmd.SetArgs(cobra.ExactArgs(1))
-	cmd.PersistentFlags().StringVarP(
-		&format,
-		"format",
-		"f",
-		conf.OutputFormat,
-		"Output format (text|json)",
+	format := newFormatOptions(cmd, conf, os)
+	cmd.PersistentFlags().BoolVarP(
+		&force,
+		"force",
+		"",
+		false,
+		"Complete the todo even if it is blocked by open todos",
 	)
 	cmd.SetRunE(
 		func(cmd *c.Command, args []string) error {
-			return runToggle(args, format, json, os, fileutil, conf, output)
+			input := &todoApp.ToggleTodoUsecaseInputDto{
+				ID:    args[0],
+				Force: force,
+			}
+			return runToggle(input, format, json, os, fileutil, conf, output)
 		},
 	)
 
@@ -43,15 +49,15 @@ func NewToggleCommand(
 }
 
 func runToggle(
-	args []string,
-	format string,
+	input *todoApp.ToggleTodoUsecaseInputDto,
+	format *formatter.Options,
 	json proxy.Json,
 	os proxy.Os,
 	fileutil utility.FileUtil,
 	conf *config.TodoConfig,
 	output *string,
 ) error {
-	todoRepo, err := todoRepo.NewTodoRepository(
+	journalRepo, err := todoRepo.NewJournalRepository(
 		conf,
 		fileutil,
 		json,
@@ -61,8 +67,28 @@ func runToggle(
 		return err
 	}
 
-	uc := todoApp.NewToggleTodoUseCase(todoRepo)
-	dto, err := uc.Run(args[0])
+	todoRepo, err := newTodoRepository(
+		conf,
+		fileutil,
+		json,
+		os,
+	)
+	if err != nil {
+		return err
+	}
+
+	idGenerator, err := todoDomain.NewIDGenerator(conf.IDFormat)
+	if err != nil {
+		return err
+	}
+
+	listOrder, err := todoDomain.ParseSortSpec(conf.DefaultSort)
+	if err != nil {
+		return err
+	}
+
+	uc := todoApp.NewToggleTodoUseCase(todoRepo, journalRepo, idGenerator, listOrder)
+	dto, err := uc.Run(input)
 	if err != nil {
 		return err
 	}
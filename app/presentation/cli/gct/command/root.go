@@ -12,6 +12,7 @@ import (
 
 func NewRootCommand(
 	cobra proxy.Cobra,
+	exec proxy.Exec,
 	json proxy.Json,
 	os proxy.Os,
 	fileutil utility.FileUtil,
@@ -41,6 +42,47 @@ func NewRootCommand(
 			conf,
 			output,
 		),
+		gct.NewArchiveCommand(
+			cobra,
+			json,
+			os,
+			fileutil,
+			conf,
+			output,
+		),
+		gct.NewEditCommand(
+			cobra,
+			exec,
+			json,
+			os,
+			fileutil,
+			conf,
+			output,
+		),
+		gct.NewBlockCommand(
+			cobra,
+			json,
+			os,
+			fileutil,
+			conf,
+			output,
+		),
+		gct.NewCancelCommand(
+			cobra,
+			json,
+			os,
+			fileutil,
+			conf,
+			output,
+		),
+		gct.NewDbCommand(
+			cobra,
+			json,
+			os,
+			fileutil,
+			conf,
+			output,
+		),
 		gct.NewDeleteCommand(
 			cobra,
 			json,
@@ -49,6 +91,54 @@ func NewRootCommand(
 			conf,
 			output,
 		),
+		gct.NewImportCommand(
+			cobra,
+			json,
+			os,
+			fileutil,
+			conf,
+			output,
+		),
+		gct.NewRedoCommand(
+			cobra,
+			json,
+			os,
+			fileutil,
+			conf,
+			output,
+		),
+		gct.NewRestoreCommand(
+			cobra,
+			json,
+			os,
+			fileutil,
+			conf,
+			output,
+		),
+		gct.NewSearchCommand(
+			cobra,
+			json,
+			os,
+			fileutil,
+			conf,
+			output,
+		),
+		gct.NewStartCommand(
+			cobra,
+			json,
+			os,
+			fileutil,
+			conf,
+			output,
+		),
+		gct.NewTagsCommand(
+			cobra,
+			json,
+			os,
+			fileutil,
+			conf,
+			output,
+		),
 		gct.NewToggleCommand(
 			cobra,
 			json,
@@ -57,6 +147,38 @@ func NewRootCommand(
 			conf,
 			output,
 		),
+		gct.NewTrashCommand(
+			cobra,
+			json,
+			os,
+			fileutil,
+			conf,
+			output,
+		),
+		gct.NewUnblockCommand(
+			cobra,
+			json,
+			os,
+			fileutil,
+			conf,
+			output,
+		),
+		gct.NewUndoCommand(
+			cobra,
+			json,
+			os,
+			fileutil,
+			conf,
+			output,
+		),
+		gct.NewWaitCommand(
+			cobra,
+			json,
+			os,
+			fileutil,
+			conf,
+			output,
+		),
 		listCmd,
 	)
 
@@ -5,6 +5,8 @@ import (
 )
 
 var (
-	Green = color.New(color.FgGreen).SprintFunc()
-	Red   = color.New(color.FgRed).SprintFunc()
+	Cyan   = color.New(color.FgCyan).SprintFunc()
+	Green  = color.New(color.FgGreen).SprintFunc()
+	Red    = color.New(color.FgRed).SprintFunc()
+	Yellow = color.New(color.FgYellow).SprintFunc()
 )
@@ -0,0 +1,156 @@
+package formatter
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+func tabulate(result any, selected []string, defaults []string) ([]string, [][]string, error) {
+	value := reflect.ValueOf(result)
+	if !value.IsValid() {
+		return nil, nil, errors.New("unsupported result type")
+	}
+	var elemType reflect.Type
+	var items []reflect.Value
+	switch value.Kind() {
+	case reflect.Slice:
+		elemType = value.Type().Elem()
+		for i := 0; i < value.Len(); i++ {
+			items = append(items, reflect.Indirect(value.Index(i)))
+		}
+	case reflect.Pointer:
+		elemType = value.Type()
+		items = append(items, reflect.Indirect(value))
+	default:
+		return nil, nil, errors.New("unsupported result type")
+	}
+	if elemType.Kind() == reflect.Pointer {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		return nil, nil, errors.New("unsupported result type")
+	}
+
+	fields := columnFields(elemType)
+	columns, err := selectColumns(fields, selected, defaults)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rows := make([][]string, 0, len(items))
+	for _, item := range items {
+		row := make([]string, len(columns))
+		for i, column := range columns {
+			row[i] = formatColumnValue(item.FieldByIndex(fields[column]))
+		}
+		rows = append(rows, row)
+	}
+	return columns, rows, nil
+}
+
+func selectColumns(fields map[string][]int, selected []string, defaults []string) ([]string, error) {
+	if len(selected) > 0 {
+		columns := make([]string, 0, len(selected))
+		for _, column := range selected {
+			column = strings.ToLower(strings.TrimSpace(column))
+			if column == "" {
+				continue
+			}
+			if _, ok := fields[column]; !ok {
+				return nil, fmt.Errorf("unknown column %q (available: %s)", column, strings.Join(sortedColumns(fields), ", "))
+			}
+			columns = append(columns, column)
+		}
+		return columns, nil
+	}
+	if len(defaults) == 0 {
+		return sortedColumns(fields), nil
+	}
+	for _, column := range defaults {
+		if _, ok := fields[column]; !ok {
+			return sortedColumns(fields), nil
+		}
+	}
+	return defaults, nil
+}
+
+func columnFields(t reflect.Type) map[string][]int {
+	names := make(map[string][]int, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		if field := t.Field(i); field.IsExported() {
+			names[snakeCase(field.Name)] = field.Index
+		}
+	}
+	fields := make(map[string][]int, len(names))
+	for name, index := range names {
+		short := name
+		for _, suffix := range []string{"_at", "_date"} {
+			short = strings.TrimSuffix(short, suffix)
+		}
+		if _, taken := names[short]; taken {
+			short = name
+		}
+		fields[short] = index
+	}
+	return fields
+}
+
+func sortedColumns(fields map[string][]int) []string {
+	type column struct {
+		name  string
+		index int
+	}
+	columns := make([]column, 0, len(fields))
+	for name, index := range fields {
+		columns = append(columns, column{name: name, index: index[0]})
+	}
+	slices.SortFunc(columns, func(a column, b column) int { return a.index - b.index })
+	names := make([]string, len(columns))
+	for i, c := range columns {
+		names[i] = c.name
+	}
+	return names
+}
+
+func snakeCase(field string) string {
+	var name strings.Builder
+	runes := []rune(field)
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1]))) {
+			name.WriteRune('_')
+		}
+		name.WriteRune(unicode.ToLower(r))
+	}
+	return name.String()
+}
+
+func formatColumnValue(value reflect.Value) string {
+	switch value.Kind() {
+	case reflect.String:
+		return value.String()
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool())
+	case reflect.Int, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10)
+	case reflect.Slice:
+		values := make([]string, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			values[i] = formatColumnValue(value.Index(i))
+		}
+		return strings.Join(values, ",")
+	case reflect.Map:
+		values := make([]string, 0, value.Len())
+		for _, key := range value.MapKeys() {
+			values = append(values, formatColumnValue(key)+":"+formatColumnValue(value.MapIndex(key)))
+		}
+		slices.Sort(values)
+		return strings.Join(values, ",")
+	default:
+		return fmt.Sprint(value.Interface())
+	}
+}
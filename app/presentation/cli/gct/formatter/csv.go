@@ -0,0 +1,38 @@
+package formatter
+
+import (
+	"encoding/csv"
+	"strings"
+)
+
+type CSVFormatter struct {
+	comma     rune
+	columns   []string
+	noHeaders bool
+}
+
+func NewCSVFormatter(comma rune, columns []string, noHeaders bool) *CSVFormatter {
+	return &CSVFormatter{
+		comma:     comma,
+		columns:   columns,
+		noHeaders: noHeaders,
+	}
+}
+
+func (f *CSVFormatter) Format(result any) (string, error) {
+	columns, rows, err := tabulate(result, f.columns, nil)
+	if err != nil {
+		return "", err
+	}
+	if !f.noHeaders {
+		rows = append([][]string{columns}, rows...)
+	}
+
+	var output strings.Builder
+	w := csv.NewWriter(&output)
+	w.Comma = f.comma
+	if err := w.WriteAll(rows); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(output.String(), "\n"), nil
+}
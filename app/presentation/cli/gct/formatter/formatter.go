@@ -11,16 +11,40 @@ type Formatter interface {
 	Format(result any) (string, error)
 }
 
+type Options struct {
+	Format    string
+	Columns   []string
+	NoHeaders bool
+	Width     int
+	Template  string
+}
+
 func NewFormatter(
-	format string,
+	options *Options,
 	json proxy.Json,
 ) (Formatter, error) {
 	var f Formatter
-	switch format {
+	switch options.Format {
+	case "csv":
+		f = NewCSVFormatter(',', options.Columns, options.NoHeaders)
 	case "json":
 		f = NewJSONFormatter(json)
+	case "markdown":
+		f = NewMarkdownFormatter()
+	case "table":
+		f = NewTableFormatter(options.Columns, options.NoHeaders, options.Width)
+	case "template":
+		t, err := NewTemplateFormatter(options.Template)
+		if err != nil {
+			return nil, err
+		}
+		f = t
 	case "text":
 		f = NewTextFormatter()
+	case "todotxt":
+		f = NewTodoTxtFormatter()
+	case "tsv":
+		f = NewCSVFormatter('\t', options.Columns, options.NoHeaders)
 	default:
 		return nil, errors.New("invalid format")
 	}
@@ -0,0 +1,43 @@
+package formatter
+
+import (
+	"errors"
+	"strings"
+
+	todoApp "github.com/yanosea/gct/app/application/gct"
+)
+
+type MarkdownFormatter struct{}
+
+func NewMarkdownFormatter() *MarkdownFormatter {
+	return &MarkdownFormatter{}
+}
+
+func (f *MarkdownFormatter) Format(result any) (string, error) {
+	var output strings.Builder
+	switch v := result.(type) {
+	case []*todoApp.ListTodoUsecaseOutputDto:
+		for _, todo := range v {
+			output.WriteString(formatChecklistItem(todo.Depth, todo.Status, todo.Title))
+		}
+	case []*todoApp.SearchTodoUsecaseOutputDto:
+		for _, todo := range v {
+			output.WriteString(formatChecklistItem(0, todo.Status, todo.Title))
+		}
+	default:
+		return "", errors.New("unsupported result type")
+	}
+	return strings.TrimSuffix(output.String(), "\n"), nil
+}
+
+func formatChecklistItem(depth int, status string, title string) string {
+	checkbox := "[ ]"
+	switch status {
+	case "done":
+		checkbox = "[x]"
+	case "cancelled":
+		checkbox = "[x]"
+		title = "~~" + title + "~~"
+	}
+	return strings.Repeat("  ", depth) + "- " + checkbox + " " + title + "\n"
+}
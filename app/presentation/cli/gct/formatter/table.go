@@ -0,0 +1,91 @@
+package formatter
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+const (
+	tableColumnGap      = "  "
+	minTableTitleWidth  = 10
+	tableTruncationTail = "…"
+)
+
+var defaultTableColumns = []string{"id", "status", "title", "created"}
+
+type TableFormatter struct {
+	columns   []string
+	noHeaders bool
+	width     int
+}
+
+func NewTableFormatter(columns []string, noHeaders bool, width int) *TableFormatter {
+	return &TableFormatter{
+		columns:   columns,
+		noHeaders: noHeaders,
+		width:     width,
+	}
+}
+
+func (f *TableFormatter) Format(result any) (string, error) {
+	columns, rows, err := tabulate(result, f.columns, defaultTableColumns)
+	if err != nil {
+		return "", err
+	}
+	if !f.noHeaders {
+		rows = append([][]string{columnHeaders(columns)}, rows...)
+	}
+	for _, row := range rows {
+		for i, cell := range row {
+			row[i] = strings.Join(strings.Fields(cell), " ")
+		}
+	}
+	return renderTable(rows, slices.Index(columns, "title"), f.width), nil
+}
+
+func columnHeaders(columns []string) []string {
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = strings.ToUpper(column)
+	}
+	return header
+}
+
+func renderTable(rows [][]string, titleColumn int, width int) string {
+	if len(rows) == 0 {
+		return ""
+	}
+	widths := make([]int, len(rows[0]))
+	for _, row := range rows {
+		for i, cell := range row {
+			widths[i] = max(widths[i], runewidth.StringWidth(cell))
+		}
+	}
+	if width > 0 && titleColumn >= 0 {
+		total := runewidth.StringWidth(tableColumnGap) * (len(widths) - 1)
+		for _, w := range widths {
+			total += w
+		}
+		if total > width {
+			widths[titleColumn] = max(minTableTitleWidth, widths[titleColumn]-(total-width))
+		}
+	}
+
+	var result strings.Builder
+	for r, row := range rows {
+		var line strings.Builder
+		for i, cell := range row {
+			if runewidth.StringWidth(cell) > widths[i] {
+				cell = runewidth.Truncate(cell, widths[i], tableTruncationTail)
+			}
+			line.WriteString(runewidth.FillRight(cell, widths[i]) + tableColumnGap)
+		}
+		result.WriteString(strings.TrimRight(line.String(), " "))
+		if r != len(rows)-1 {
+			result.WriteString("\n")
+		}
+	}
+	return result.String()
+}
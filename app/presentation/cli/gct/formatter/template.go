@@ -0,0 +1,132 @@
+package formatter
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/mattn/go-runewidth"
+)
+
+var templateColors = map[string]func(a ...any) string{
+	"cyan":   Cyan,
+	"green":  Green,
+	"red":    Red,
+	"yellow": Yellow,
+}
+
+var templateFuncs = template.FuncMap{
+	"color":    templateColor,
+	"join":     templateJoin,
+	"relative": relativeTime,
+	"status":   formatStatus,
+	"truncate": templateTruncate,
+}
+
+type TemplateFormatter struct {
+	template *template.Template
+}
+
+func NewTemplateFormatter(text string) (*TemplateFormatter, error) {
+	if text == "" {
+		return nil, errors.New("the template format needs --template or --template-file")
+	}
+	t, err := template.New("format").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+	return &TemplateFormatter{
+		template: t,
+	}, nil
+}
+
+func (f *TemplateFormatter) Format(result any) (string, error) {
+	value := reflect.ValueOf(result)
+	items := []any{result}
+	if value.Kind() == reflect.Slice {
+		items = make([]any, value.Len())
+		for i := range items {
+			items[i] = value.Index(i).Interface()
+		}
+	}
+
+	var output strings.Builder
+	for _, item := range items {
+		var b strings.Builder
+		if err := f.template.Execute(&b, item); err != nil {
+			return "", err
+		}
+		output.WriteString(strings.TrimSuffix(b.String(), "\n") + "\n")
+	}
+	return strings.TrimSuffix(output.String(), "\n"), nil
+}
+
+func templateColor(name string, text string) (string, error) {
+	sprint, ok := templateColors[name]
+	if !ok {
+		return "", fmt.Errorf("unknown color %q (expected cyan, green, red or yellow)", name)
+	}
+	return sprint(text), nil
+}
+
+func templateJoin(sep string, values []string) string {
+	return strings.Join(values, sep)
+}
+
+func templateTruncate(width int, text string) string {
+	return runewidth.Truncate(text, width, tableTruncationTail)
+}
+
+func relativeTime(value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	now := time.Now()
+	if day, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		year, month, date := now.Date()
+		today := time.Date(year, month, date, 0, 0, 0, 0, now.Location())
+		days := int(math.Round(day.Sub(today).Hours() / 24))
+		switch days {
+		case 0:
+			return "today", nil
+		case 1:
+			return "tomorrow", nil
+		case -1:
+			return "yesterday", nil
+		}
+		return relativeUnits(days, "day"), nil
+	}
+	t, err := time.ParseInLocation("2006-01-02 15:04:05", value, now.Location())
+	if err != nil {
+		return "", fmt.Errorf("invalid time %q (expected YYYY-MM-DD or YYYY-MM-DD HH:MM:SS)", value)
+	}
+	d := t.Sub(now)
+	switch {
+	case d.Abs() < time.Minute:
+		return "just now", nil
+	case d.Abs() < time.Hour:
+		return relativeUnits(int(d/time.Minute), "minute"), nil
+	case d.Abs() < 24*time.Hour:
+		return relativeUnits(int(d/time.Hour), "hour"), nil
+	default:
+		return relativeUnits(int(d/(24*time.Hour)), "day"), nil
+	}
+}
+
+func relativeUnits(n int, unit string) string {
+	count := n
+	if count < 0 {
+		count = -count
+	}
+	if count != 1 {
+		unit += "s"
+	}
+	if n < 0 {
+		return fmt.Sprintf("%d %s ago", count, unit)
+	}
+	return fmt.Sprintf("in %d %s", count, unit)
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"unicode/utf8"
 
 	todoApp "github.com/yanosea/gct/app/application/gct"
 )
@@ -17,36 +18,316 @@ func NewTextFormatter() *TextFormatter {
 func (f *TextFormatter) Format(result any) (string, error) {
 	switch v := result.(type) {
 	case *todoApp.AddTodoUsecaseOutputDto:
-		return fmt.Sprintf("Added todo : %s (ID: %s, CREATED AT: %s)", v.Title, v.ID, v.CreatedAt), nil
+		return fmt.Sprintf("Added todo : %s%s%s (ID: %s%s%s%s%s)%s", formatPriority(v.Priority), v.Title, formatTags(v.Tags), v.ID, formatParent(v.ParentID), formatDueDate(v.DueDate, false, false), formatRecurrence(v.Recurrence), formatCreatedAt(v.CreatedAt), formatNotes(v.Notes)), nil
+	case *todoApp.EditTodoUsecaseOutputDto:
+		status := formatStatus(v.Status)
+		return fmt.Sprintf("Edited todo : %s %s%s%s (ID: %s%s%s%s)%s", status, formatPriority(v.Priority), v.Title, formatTags(v.Tags), v.ID, formatDueDate(v.DueDate, false, false), formatRecurrence(v.Recurrence), formatCreatedAt(v.CreatedAt), formatNotes(v.Notes)), nil
 	case *todoApp.DeleteTodoUsecaseOutputDto:
-		status := "[ ]"
-		if v.Done {
-			status = Green("[✓]")
-		}
-		return fmt.Sprintf("Deleted todo : %s %s (ID: %s, CREATED AT: %s)", status, v.Title, v.ID, v.CreatedAt), nil
+		status := formatStatus(v.Status)
+		return fmt.Sprintf("Moved todo to trash : %s %s%s%s (ID: %s%s%s, DELETED AT: %s)%s", status, formatPriority(v.Priority), v.Title, formatTags(v.Tags), v.ID, formatDueDate(v.DueDate, false, false), formatCreatedAt(v.CreatedAt), v.DeletedAt, formatSubtaskCount(v.Subtasks)), nil
+	case *todoApp.RestoreTodoUsecaseOutputDto:
+		status := formatStatus(v.Status)
+		return fmt.Sprintf("Restored todo : %s %s%s%s (ID: %s%s%s)%s", status, formatPriority(v.Priority), v.Title, formatTags(v.Tags), v.ID, formatDueDate(v.DueDate, false, false), formatCreatedAt(v.CreatedAt), formatSubtaskCount(v.Subtasks)), nil
 	case *todoApp.ToggleTodoUsecaseOutputDto:
-		status := "[ ]"
-		if v.Done {
-			status = Green("[✓]")
-		}
-		return fmt.Sprintf("Toggled todo : %s %s (ID: %s, CREATED AT: %s)", status, v.Title, v.ID, v.CreatedAt), nil
+		status := formatStatus(v.Status)
+		return fmt.Sprintf("Toggled todo : %s %s%s%s (ID: %s%s%s)%s", status, formatPriority(v.Priority), v.Title, formatTags(v.Tags), v.ID, formatDueDate(v.DueDate, false, false), formatCreatedAt(v.CreatedAt), formatNextOccurrence(v.Title, v.NextID, v.NextDueDate, v.Recurrence)), nil
+	case *todoApp.ChangeTodoStatusUsecaseOutputDto:
+		return fmt.Sprintf("Marked todo as %s : %s %s%s%s (ID: %s%s%s)%s", formatStatusLabel(v.Status), formatStatus(v.Status), formatPriority(v.Priority), v.Title, formatTags(v.Tags), v.ID, formatDueDate(v.DueDate, false, false), formatCreatedAt(v.CreatedAt), formatNextOccurrence(v.Title, v.NextID, v.NextDueDate, v.Recurrence)), nil
 	case []*todoApp.ListTodoUsecaseOutputDto:
 		if len(v) == 0 {
 			return "No todos found", nil
 		}
 		var result = strings.Builder{}
 		for i, todo := range v {
-			status := "[ ]"
-			if todo.Done {
-				status = Green("[✓]")
+			status := formatStatus(todo.Status)
+			result.WriteString(fmt.Sprintf("%s%s %s%s%s%s%s (%sID: %s%s%s%s%s)%s", strings.Repeat("    ", todo.Depth), status, formatPriority(todo.Priority), todo.Title, formatProgress(todo.Completed, todo.Subtasks), formatNotesMarker(todo.Notes), formatTags(todo.Tags), formatPosition(todo.Position), todo.ID, formatDueDate(todo.DueDate, todo.Overdue, todo.DueToday), formatRecurrence(todo.Recurrence), formatBlockers(todo.Blockers), formatCreatedAt(todo.CreatedAt), formatArchived(todo.Archived)))
+			if i != len(v)-1 {
+				result.WriteString("\n")
+			}
+		}
+		return result.String(), nil
+	case []*todoApp.SearchTodoUsecaseOutputDto:
+		if len(v) == 0 {
+			return "No matching todos found", nil
+		}
+		var result = strings.Builder{}
+		for i, todo := range v {
+			result.WriteString(fmt.Sprintf("%s %s%s%s (%sID: %s%s%s)", formatStatus(todo.Status), formatPriority(todo.Priority), highlight(todo.Title, todo.Highlights), formatTags(todo.Tags), formatPosition(todo.Position), todo.ID, formatDueDate(todo.DueDate, todo.Overdue, todo.DueToday), formatCreatedAt(todo.CreatedAt)))
+			if containsAny(todo.Notes, todo.Highlights) {
+				result.WriteString(formatNotes(highlight(todo.Notes, todo.Highlights)))
+			}
+			if i != len(v)-1 {
+				result.WriteString("\n")
+			}
+		}
+		return result.String(), nil
+	case []*todoApp.ListTagsUsecaseOutputDto:
+		if len(v) == 0 {
+			return "No tags found", nil
+		}
+		width := 0
+		for _, tag := range v {
+			width = max(width, len(tag.Tag)+1)
+		}
+		var result = strings.Builder{}
+		for i, tag := range v {
+			result.WriteString(fmt.Sprintf("%s%s %d", Cyan("+"+tag.Tag), strings.Repeat(" ", width-len(tag.Tag)-1), tag.Count))
+			if i != len(v)-1 {
+				result.WriteString("\n")
 			}
-			result.WriteString(fmt.Sprintf("%s %s (ID: %s, CREATED AT: %s)", status, todo.Title, todo.ID, todo.CreatedAt))
+		}
+		return result.String(), nil
+	case []*todoApp.ListTrashUsecaseOutputDto:
+		if len(v) == 0 {
+			return "Trash is empty", nil
+		}
+		var result = strings.Builder{}
+		for i, todo := range v {
+			status := formatStatus(todo.Status)
+			result.WriteString(fmt.Sprintf("%s %s%s%s%s (%sID: %s%s%s, DELETED AT: %s)", status, formatPriority(todo.Priority), todo.Title, formatNotesMarker(todo.Notes), formatTags(todo.Tags), formatPosition(todo.Position), todo.ID, formatDueDate(todo.DueDate, false, false), formatCreatedAt(todo.CreatedAt), todo.DeletedAt))
 			if i != len(v)-1 {
 				result.WriteString("\n")
 			}
 		}
 		return result.String(), nil
+	case []*todoApp.PurgeTrashUsecaseOutputDto:
+		if len(v) == 0 {
+			return "No todos to purge", nil
+		}
+		var result = strings.Builder{}
+		for i, todo := range v {
+			result.WriteString(fmt.Sprintf("Purged todo : %s (ID: %s, DELETED AT: %s)", todo.Title, todo.ID, todo.DeletedAt))
+			if i != len(v)-1 {
+				result.WriteString("\n")
+			}
+		}
+		return result.String(), nil
+	case []*todoApp.ArchiveTodoUsecaseOutputDto:
+		if len(v) == 0 {
+			return "No todos to archive", nil
+		}
+		var result = strings.Builder{}
+		for i, todo := range v {
+			completedAt := ""
+			if todo.CompletedAt != "" {
+				completedAt = ", COMPLETED AT: " + todo.CompletedAt
+			}
+			result.WriteString(fmt.Sprintf("Archived todo : %s (ID: %s%s, ARCHIVE: %s)", todo.Title, todo.ID, completedAt, todo.Period))
+			if i != len(v)-1 {
+				result.WriteString("\n")
+			}
+		}
+		return result.String(), nil
+	case []*todoApp.ImportTodoUsecaseOutputDto:
+		var result = strings.Builder{}
+		for i, todo := range v {
+			status := formatStatus(todo.Status)
+			result.WriteString(fmt.Sprintf("Imported todo : %s %s%s%s (ID: %s%s%s%s)", status, formatPriority(todo.Priority), todo.Title, formatTags(todo.Tags), todo.ID, formatParent(todo.ParentID), formatDueDate(todo.DueDate, false, false), formatCreatedAt(todo.CreatedAt)))
+			if i != len(v)-1 {
+				result.WriteString("\n")
+			}
+		}
+		return result.String(), nil
+	case *todoApp.BlockTodoUsecaseOutputDto:
+		return fmt.Sprintf("Blocked todo : %s (ID: %s) by %s (ID: %s)", v.Title, v.ID, v.BlockerTitle, v.BlockerID), nil
+	case *todoApp.UnblockTodoUsecaseOutputDto:
+		if len(v.BlockedBy) > 0 {
+			return fmt.Sprintf("Unblocked todo : %s (ID: %s, STILL BLOCKED BY: %s)", v.Title, v.ID, strings.Join(v.BlockedBy, ", ")), nil
+		}
+		return fmt.Sprintf("Unblocked todo : %s (ID: %s)", v.Title, v.ID), nil
+	case *todoApp.UndoTodoUsecaseOutputDto:
+		return fmt.Sprintf("Undid %s : %s (ID: %s)", v.Operation, v.Title, v.ID), nil
+	case *todoApp.RedoTodoUsecaseOutputDto:
+		return fmt.Sprintf("Redid %s : %s (ID: %s)", v.Operation, v.Title, v.ID), nil
+	case *todoApp.MigrateDatabaseUsecaseOutputDto:
+		if len(v.Migrations) == 0 {
+			return fmt.Sprintf("Database is up to date (SCHEMA VERSION: %d)", v.TargetVersion), nil
+		}
+		var result = strings.Builder{}
+		if v.DryRun {
+			result.WriteString(fmt.Sprintf("Pending migrations (SCHEMA VERSION: %d -> %d) :", v.CurrentVersion, v.TargetVersion))
+		} else {
+			result.WriteString(fmt.Sprintf("Migrated database (SCHEMA VERSION: %d -> %d, BACKUP: %s) :", v.CurrentVersion, v.TargetVersion, v.BackupFilePath))
+		}
+		for i, migration := range v.Migrations {
+			result.WriteString(fmt.Sprintf("\n  v%d: %s", v.CurrentVersion+i+1, migration))
+		}
+		if v.DryRun {
+			result.WriteString(Yellow(fmt.Sprintf("\nDry run: %d todos would be migrated, nothing was written", v.TodoCount)))
+		}
+		return result.String(), nil
 	default:
 		return "", errors.New("unsupported result type")
 	}
 }
+
+func formatStatus(status string) string {
+	switch status {
+	case "in_progress":
+		return Cyan("[▶]")
+	case "waiting":
+		return Yellow("[…]")
+	case "done":
+		return Green("[✓]")
+	case "cancelled":
+		return Red("[✗]")
+	default:
+		return "[ ]"
+	}
+}
+
+func formatStatusLabel(status string) string {
+	return strings.ReplaceAll(status, "_", " ")
+}
+
+func formatPriority(priority string) string {
+	switch priority {
+	case "high":
+		return Red("!!!") + " "
+	case "medium":
+		return Yellow("!!") + " "
+	case "low":
+		return "! "
+	default:
+		return ""
+	}
+}
+
+func formatTags(tags []string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	return " " + Cyan("+"+strings.Join(tags, " +"))
+}
+
+func formatPosition(position int) string {
+	if position == 0 {
+		return ""
+	}
+	return fmt.Sprintf("#%d, ", position)
+}
+
+func formatCreatedAt(createdAt string) string {
+	if createdAt == "" {
+		return ""
+	}
+	return ", CREATED AT: " + createdAt
+}
+
+func formatParent(parentID string) string {
+	if parentID == "" {
+		return ""
+	}
+	return ", PARENT: " + parentID
+}
+
+func formatRecurrence(recurrence string) string {
+	if recurrence == "" {
+		return ""
+	}
+	return ", " + Cyan("REPEAT: "+recurrence)
+}
+
+func formatBlockers(blockers []string) string {
+	if len(blockers) == 0 {
+		return ""
+	}
+	return ", " + Red("BLOCKED BY: "+strings.Join(blockers, ", "))
+}
+
+func formatNextOccurrence(title string, nextID string, nextDueDate string, recurrence string) string {
+	if nextID == "" {
+		return ""
+	}
+	return fmt.Sprintf("\n    next occurrence : %s (ID: %s%s%s)", title, nextID, formatDueDate(nextDueDate, false, false), formatRecurrence(recurrence))
+}
+
+func formatProgress(completed int, subtasks int) string {
+	if subtasks == 0 {
+		return ""
+	}
+	progress := fmt.Sprintf(" (%d/%d)", completed, subtasks)
+	if completed == subtasks {
+		return Green(progress)
+	}
+	return progress
+}
+
+func formatSubtaskCount(subtasks int) string {
+	switch subtasks {
+	case 0:
+		return ""
+	case 1:
+		return "\n    with 1 subtask"
+	default:
+		return fmt.Sprintf("\n    with %d subtasks", subtasks)
+	}
+}
+
+func formatArchived(archived bool) string {
+	if !archived {
+		return ""
+	}
+	return " " + Yellow("[archived]")
+}
+
+func highlight(text string, terms []string) string {
+	if text == "" || len(terms) == 0 {
+		return text
+	}
+	var result = strings.Builder{}
+	for i := 0; i < len(text); {
+		matched := 0
+		for _, term := range terms {
+			if len(term) > matched && i+len(term) <= len(text) && strings.EqualFold(text[i:i+len(term)], term) {
+				matched = len(term)
+			}
+		}
+		if matched == 0 {
+			_, size := utf8.DecodeRuneInString(text[i:])
+			result.WriteString(text[i : i+size])
+			i += size
+			continue
+		}
+		result.WriteString(Yellow(text[i : i+matched]))
+		i += matched
+	}
+	return result.String()
+}
+
+func containsAny(text string, terms []string) bool {
+	for _, term := range terms {
+		if strings.Contains(strings.ToLower(text), term) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatNotesMarker(notes string) string {
+	if notes == "" {
+		return ""
+	}
+	return " ✎"
+}
+
+func formatNotes(notes string) string {
+	if notes == "" {
+		return ""
+	}
+	return "\n    " + strings.ReplaceAll(notes, "\n", "\n    ")
+}
+
+func formatDueDate(dueDate string, overdue bool, dueToday bool) string {
+	if dueDate == "" {
+		return ""
+	}
+	switch {
+	case overdue:
+		return ", " + Red(fmt.Sprintf("DUE: %s (overdue)", dueDate))
+	case dueToday:
+		return ", " + Yellow(fmt.Sprintf("DUE: %s (today)", dueDate))
+	default:
+		return fmt.Sprintf(", DUE: %s", dueDate)
+	}
+}
@@ -0,0 +1,69 @@
+package formatter
+
+import (
+	"errors"
+	"maps"
+	"strings"
+
+	todoApp "github.com/yanosea/gct/app/application/gct"
+
+	"github.com/yanosea/gct/pkg/todotxt"
+)
+
+type TodoTxtFormatter struct{}
+
+func NewTodoTxtFormatter() *TodoTxtFormatter {
+	return &TodoTxtFormatter{}
+}
+
+func (f *TodoTxtFormatter) Format(result any) (string, error) {
+	todos, ok := result.([]*todoApp.ListTodoUsecaseOutputDto)
+	if !ok {
+		return "", errors.New("unsupported result type")
+	}
+	referenced := make(map[string]bool)
+	for _, todo := range todos {
+		if todo.ParentID != "" {
+			referenced[todo.ParentID] = true
+		}
+		for _, id := range todo.BlockedBy {
+			referenced[id] = true
+		}
+	}
+
+	lines := make([]string, len(todos))
+	for i, todo := range todos {
+		task := &todotxt.Task{
+			Done:         todo.Status == "done" || todo.Status == "cancelled",
+			CreationDate: todoTxtDate(todo.CreatedAt),
+			Description:  todo.Title,
+			Extras:       maps.Clone(todo.Extras),
+		}
+		if task.Done {
+			task.CompletionDate = todoTxtDate(todo.CompletedAt)
+		}
+		task.Projects, task.Contexts = todotxt.SplitTags(todo.Tags)
+		fields := todotxt.Fields{
+			Priority:   todotxt.PriorityLetter(todo.Priority),
+			Due:        todo.DueDate,
+			Recurrence: todo.Recurrence,
+			Notes:      todo.Notes,
+			Parent:     todo.ParentID,
+			BlockedBy:  todo.BlockedBy,
+		}
+		if todo.Status != "todo" && todo.Status != "done" {
+			fields.Status = todo.Status
+		}
+		if referenced[todo.ID] {
+			fields.ID = todo.ID
+		}
+		task.SetFields(fields)
+		lines[i] = task.String()
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+func todoTxtDate(value string) string {
+	date, _, _ := strings.Cut(value, " ")
+	return date
+}
@@ -0,0 +1,60 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strings"
+
+	todoApp "github.com/yanosea/gct/app/application/gct"
+)
+
+type CSVImporter struct {
+	comma rune
+}
+
+func NewCSVImporter(comma rune) *CSVImporter {
+	return &CSVImporter{
+		comma: comma,
+	}
+}
+
+func (i *CSVImporter) Import(data []byte) (*todoApp.ImportTodoUsecaseInputDto, error) {
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\ufeff"))))
+	r.Comma = i.comma
+	header, err := r.Read()
+	if err == io.EOF {
+		return nil, errors.New("import file is empty")
+	}
+	if err != nil {
+		return nil, err
+	}
+	columns := make([]string, len(header))
+	for j, column := range header {
+		columns[j] = strings.ReplaceAll(strings.ToLower(strings.TrimSpace(column)), " ", "_")
+	}
+
+	input := &todoApp.ImportTodoUsecaseInputDto{}
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := r.FieldPos(0)
+		row := &todoApp.ImportTodoUsecaseInputRowDto{
+			Line:   line,
+			Fields: make(map[string]string, len(columns)),
+		}
+		for j, value := range record {
+			if columns[j] != "" {
+				row.Fields[columns[j]] = value
+			}
+		}
+		input.Rows = append(input.Rows, row)
+	}
+	return input, nil
+}
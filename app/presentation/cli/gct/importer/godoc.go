@@ -0,0 +1,2 @@
+// Package importer is the importer package.
+package importer
@@ -0,0 +1,33 @@
+package importer
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	todoApp "github.com/yanosea/gct/app/application/gct"
+)
+
+type Importer interface {
+	Import(data []byte) (*todoApp.ImportTodoUsecaseInputDto, error)
+}
+
+func NewImporter(format string, path string) (Importer, error) {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(path)), ".")
+	}
+	var i Importer
+	switch format {
+	case "csv":
+		i = NewCSVImporter(',')
+	case "markdown", "md":
+		i = NewMarkdownImporter()
+	case "todotxt", "txt":
+		i = NewTodoTxtImporter()
+	case "tsv":
+		i = NewCSVImporter('\t')
+	default:
+		return nil, fmt.Errorf("invalid import format %q (expected csv, tsv, markdown or todotxt)", format)
+	}
+	return i, nil
+}
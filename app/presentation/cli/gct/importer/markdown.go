@@ -0,0 +1,61 @@
+package importer
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	todoApp "github.com/yanosea/gct/app/application/gct"
+)
+
+var checklistItemPattern = regexp.MustCompile(`^([ \t]*)(?:[-*+]|\d+[.)])[ \t]+\[([ xX])\][ \t]+(.+)$`)
+
+type MarkdownImporter struct{}
+
+func NewMarkdownImporter() *MarkdownImporter {
+	return &MarkdownImporter{}
+}
+
+func (i *MarkdownImporter) Import(data []byte) (*todoApp.ImportTodoUsecaseInputDto, error) {
+	type parent struct {
+		indent int
+		id     string
+	}
+	parents := make([]parent, 0)
+	input := &todoApp.ImportTodoUsecaseInputDto{}
+	for n, line := range strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n") {
+		match := checklistItemPattern.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		indent := len(strings.ReplaceAll(match[1], "\t", "    "))
+		for len(parents) > 0 && parents[len(parents)-1].indent >= indent {
+			parents = parents[:len(parents)-1]
+		}
+
+		id := strconv.Itoa(n + 1)
+		title := strings.TrimSpace(match[3])
+		status := "todo"
+		if match[2] != " " {
+			status = "done"
+			if cancelled, ok := strings.CutPrefix(title, "~~"); ok && strings.HasSuffix(cancelled, "~~") {
+				status = "cancelled"
+				title = strings.TrimSuffix(cancelled, "~~")
+			}
+		}
+		row := &todoApp.ImportTodoUsecaseInputRowDto{
+			Line: n + 1,
+			Fields: map[string]string{
+				"id":     id,
+				"title":  title,
+				"status": status,
+			},
+		}
+		if len(parents) > 0 {
+			row.Fields["parent_id"] = parents[len(parents)-1].id
+		}
+		input.Rows = append(input.Rows, row)
+		parents = append(parents, parent{indent: indent, id: id})
+	}
+	return input, nil
+}
@@ -0,0 +1,55 @@
+package importer
+
+import (
+	"strings"
+
+	todoApp "github.com/yanosea/gct/app/application/gct"
+
+	"github.com/yanosea/gct/pkg/todotxt"
+)
+
+type TodoTxtImporter struct{}
+
+func NewTodoTxtImporter() *TodoTxtImporter {
+	return &TodoTxtImporter{}
+}
+
+func (i *TodoTxtImporter) Import(data []byte) (*todoApp.ImportTodoUsecaseInputDto, error) {
+	input := &todoApp.ImportTodoUsecaseInputDto{}
+	for n, line := range strings.Split(strings.ReplaceAll(string(data), "\r\n", "\n"), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		task, err := todotxt.Parse(line)
+		if err != nil {
+			return nil, err
+		}
+
+		fields, extras := task.Fields()
+		row := &todoApp.ImportTodoUsecaseInputRowDto{
+			Line: n + 1,
+			Fields: map[string]string{
+				"title":      task.Description,
+				"priority":   todotxt.PriorityName(fields.Priority),
+				"created":    task.CreationDate,
+				"due":        fields.Due,
+				"recurrence": fields.Recurrence,
+				"status":     fields.Status,
+				"notes":      fields.Notes,
+				"id":         fields.ID,
+				"parent_id":  fields.Parent,
+				"blocked_by": strings.Join(fields.BlockedBy, ","),
+			},
+			Extras: extras,
+		}
+		if task.Done {
+			row.Fields["completed_at"] = task.CompletionDate
+			if row.Fields["status"] == "" {
+				row.Fields["status"] = "done"
+			}
+		}
+		row.Fields["tags"] = strings.Join(task.Tags(), ",")
+		input.Rows = append(input.Rows, row)
+	}
+	return input, nil
+}
@@ -10,6 +10,7 @@ import (
 type TodoCliParams struct {
 	Cobra     proxy.Cobra
 	Envconfig proxy.Envconfig
+	Exec      proxy.Exec
 	Json      proxy.Json
 	Os        proxy.Os
 	FileUtil  utility.FileUtil
@@ -21,9 +22,10 @@ var (
 	todoCliParams = TodoCliParams{
 		Cobra:     proxy.NewCobra(),
 		Envconfig: proxy.NewEnvconfig(),
+		Exec:      proxy.NewExec(),
 		Json:      proxy.NewJson(),
 		Os:        os,
-		FileUtil:  utility.NewFileUtil(os, proxy.NewJson()),
+		FileUtil:  utility.NewFileUtil(os, proxy.NewJson(), proxy.NewFlock()),
 	}
 )
 
@@ -33,6 +35,7 @@ func main() {
 	)
 	if exitCode := cli.Init(
 		todoCliParams.Envconfig,
+		todoCliParams.Exec,
 		todoCliParams.Json,
 		todoCliParams.Os,
 		todoCliParams.FileUtil,
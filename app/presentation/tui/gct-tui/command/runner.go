@@ -9,19 +9,22 @@ import (
 )
 
 type Runner struct {
-	bubbletea proxy.Bubbletea
-	usecases  *model.Usecases
+	bubbletea   proxy.Bubbletea
+	usecases    *model.Usecases
+	defaultSort string
 }
 
-func NewRootRunner(bubbletea proxy.Bubbletea, usecases *model.Usecases) *Runner {
+func NewRootRunner(bubbletea proxy.Bubbletea, usecases *model.Usecases, defaultSort string) *Runner {
 	return &Runner{
-		bubbletea: bubbletea,
-		usecases:  usecases,
+		bubbletea:   bubbletea,
+		usecases:    usecases,
+		defaultSort: defaultSort,
 	}
 }
 
 func (r *Runner) Run() int {
 	m := model.NewModel(r.usecases)
+	m.State().SetSort(r.defaultSort)
 	program := r.bubbletea.NewProgram(m)
 
 	if _, err := program.Run(); err != nil {
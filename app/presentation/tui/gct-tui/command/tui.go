@@ -6,7 +6,9 @@ import (
 
 	todoApp "github.com/yanosea/gct/app/application/gct"
 	"github.com/yanosea/gct/app/config"
+	todoDomain "github.com/yanosea/gct/app/domain/todo"
 	"github.com/yanosea/gct/app/infrastructure/json/repository"
+	todoTxtRepository "github.com/yanosea/gct/app/infrastructure/todotxt/repository"
 	"github.com/yanosea/gct/app/presentation/tui/gct-tui/model"
 	"github.com/yanosea/gct/pkg/proxy"
 	"github.com/yanosea/gct/pkg/utility"
@@ -19,7 +21,7 @@ type Tui struct {
 	Os            proxy.Os
 	FileUtil      utility.FileUtil
 	Config        *config.TodoConfig
-	NewRootRunner func(proxy.Bubbletea, *model.Usecases) *Runner
+	NewRootRunner func(proxy.Bubbletea, *model.Usecases, string) *Runner
 }
 
 func NewTui(
@@ -49,19 +51,55 @@ func (t *Tui) Run() int {
 	}
 	t.Config = conf
 
-	todoRepo, err := repository.NewTodoRepository(conf, t.FileUtil, t.Json, t.Os)
+	idGenerator, err := todoDomain.NewIDGenerator(conf.IDFormat)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
+		return 1
+	}
+
+	listOrder, err := todoDomain.ParseSortSpec(conf.DefaultSort)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
+		return 1
+	}
+
+	var todoRepo todoDomain.TodoRepository
+	if conf.TodoTxtFile != "" {
+		todoRepo, err = todoTxtRepository.NewTodoRepository(conf, t.FileUtil, t.Os)
+	} else {
+		todoRepo, err = repository.NewTodoRepository(conf, t.FileUtil, t.Json, t.Os)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize repository: %v\n", err)
+		return 1
+	}
+
+	journalRepo, err := repository.NewJournalRepository(conf, t.FileUtil, t.Json, t.Os)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to initialize repository: %v\n", err)
+		return 1
+	}
+
+	archiveRepo, err := repository.NewArchiveRepository(conf, t.FileUtil, t.Json, t.Os)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to initialize repository: %v\n", err)
 		return 1
 	}
 
 	usecases := &model.Usecases{
-		List:   todoApp.NewListTodoUseCase(todoRepo),
-		Add:    todoApp.NewAddTodoUseCase(todoRepo),
-		Delete: todoApp.NewDeleteTodoUseCase(todoRepo),
-		Toggle: todoApp.NewToggleTodoUseCase(todoRepo),
+		List:    todoApp.NewListTodoUseCase(todoRepo, archiveRepo, listOrder),
+		Add:     todoApp.NewAddTodoUseCase(todoRepo, journalRepo, idGenerator, listOrder),
+		Edit:    todoApp.NewEditTodoUseCase(todoRepo, journalRepo, listOrder),
+		Delete:  todoApp.NewDeleteTodoUseCase(todoRepo, journalRepo, listOrder),
+		Toggle:  todoApp.NewToggleTodoUseCase(todoRepo, journalRepo, idGenerator, listOrder),
+		Status:  todoApp.NewChangeTodoStatusUseCase(todoRepo, journalRepo, idGenerator, listOrder),
+		Undo:    todoApp.NewUndoTodoUseCase(todoRepo, journalRepo),
+		Redo:    todoApp.NewRedoTodoUseCase(todoRepo, journalRepo),
+		Trash:   todoApp.NewListTrashUseCase(todoRepo, listOrder),
+		Restore: todoApp.NewRestoreTodoUseCase(todoRepo, journalRepo, listOrder),
+		Archive: todoApp.NewListArchiveUseCase(archiveRepo),
 	}
 
-	runner := t.NewRootRunner(t.Bubbletea, usecases)
+	runner := t.NewRootRunner(t.Bubbletea, usecases, conf.DefaultSort)
 	return runner.Run()
 }
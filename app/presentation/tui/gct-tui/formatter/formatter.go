@@ -21,12 +21,26 @@ func (f *TuiFormatter) Format(result any) (string, error) {
 	switch v := result.(type) {
 	case []*todoApp.ListTodoUsecaseOutputDto:
 		return f.formatTodoList(v), nil
+	case []*todoApp.ListTagsUsecaseOutputDto:
+		return f.formatTagList(v), nil
 	case *todoApp.AddTodoUsecaseOutputDto:
 		return f.formatAddResult(v), nil
+	case *todoApp.EditTodoUsecaseOutputDto:
+		return f.formatEditResult(v), nil
 	case *todoApp.DeleteTodoUsecaseOutputDto:
 		return f.formatDeleteResult(v), nil
 	case *todoApp.ToggleTodoUsecaseOutputDto:
 		return f.formatToggleResult(v), nil
+	case []*todoApp.ListTrashUsecaseOutputDto:
+		return f.formatTrashList(v), nil
+	case []*todoApp.ListArchiveUsecaseOutputDto:
+		return f.formatArchiveList(v), nil
+	case *todoApp.RestoreTodoUsecaseOutputDto:
+		return f.formatRestoreResult(v), nil
+	case *todoApp.UndoTodoUsecaseOutputDto:
+		return f.formatUndoResult(v), nil
+	case *todoApp.RedoTodoUsecaseOutputDto:
+		return f.formatRedoResult(v), nil
 	case string:
 		return v, nil
 	default:
@@ -41,7 +55,19 @@ func (f *TuiFormatter) formatTodoList(todos []*todoApp.ListTodoUsecaseOutputDto)
 
 	var result string
 	for _, todo := range todos {
-		result += FormatTodoItem(todo.Title, todo.Done, false) + "\n"
+		result += FormatTodoItem(todo, false, false) + "\n"
+	}
+	return result
+}
+
+func (f *TuiFormatter) formatTagList(tags []*todoApp.ListTagsUsecaseOutputDto) string {
+	if len(tags) == 0 {
+		return "No tags found."
+	}
+
+	var result string
+	for _, tag := range tags {
+		result += fmt.Sprintf("%s %d\n", FormatTags([]string{tag.Tag}), tag.Count)
 	}
 	return result
 }
@@ -50,8 +76,12 @@ func (f *TuiFormatter) formatAddResult(result *todoApp.AddTodoUsecaseOutputDto)
 	return FormatSuccess(fmt.Sprintf("Added todo: %s", result.Title))
 }
 
+func (f *TuiFormatter) formatEditResult(result *todoApp.EditTodoUsecaseOutputDto) string {
+	return FormatSuccess(fmt.Sprintf("Edited todo: %s", result.Title))
+}
+
 func (f *TuiFormatter) formatDeleteResult(result *todoApp.DeleteTodoUsecaseOutputDto) string {
-	return FormatSuccess(fmt.Sprintf("Deleted todo: %s", result.Title))
+	return FormatSuccess(fmt.Sprintf("Moved todo to trash: %s", result.Title))
 }
 
 func (f *TuiFormatter) formatToggleResult(result *todoApp.ToggleTodoUsecaseOutputDto) string {
@@ -62,6 +92,42 @@ func (f *TuiFormatter) formatToggleResult(result *todoApp.ToggleTodoUsecaseOutpu
 	return FormatSuccess(fmt.Sprintf("Toggled todo: %s (now %s)", result.Title, status))
 }
 
+func (f *TuiFormatter) formatTrashList(todos []*todoApp.ListTrashUsecaseOutputDto) string {
+	if len(todos) == 0 {
+		return "Trash is empty."
+	}
+
+	var result string
+	for _, todo := range todos {
+		result += FormatTrashItem(todo, false) + "\n"
+	}
+	return result
+}
+
+func (f *TuiFormatter) formatArchiveList(todos []*todoApp.ListArchiveUsecaseOutputDto) string {
+	if len(todos) == 0 {
+		return "Archive is empty."
+	}
+
+	var result string
+	for _, todo := range todos {
+		result += FormatArchiveItem(todo, false) + "\n"
+	}
+	return result
+}
+
+func (f *TuiFormatter) formatRestoreResult(result *todoApp.RestoreTodoUsecaseOutputDto) string {
+	return FormatSuccess(fmt.Sprintf("Restored todo: %s", result.Title))
+}
+
+func (f *TuiFormatter) formatUndoResult(result *todoApp.UndoTodoUsecaseOutputDto) string {
+	return FormatSuccess(fmt.Sprintf("Undid %s: %s", result.Operation, result.Title))
+}
+
+func (f *TuiFormatter) formatRedoResult(result *todoApp.RedoTodoUsecaseOutputDto) string {
+	return FormatSuccess(fmt.Sprintf("Redid %s: %s", result.Operation, result.Title))
+}
+
 func NewFormatter(format string) (Formatter, error) {
 	switch format {
 	case "tui", "styled":
@@ -1,7 +1,12 @@
 package formatter
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/charmbracelet/lipgloss"
+
+	todoApp "github.com/yanosea/gct/app/application/gct"
 )
 
 var (
@@ -26,12 +31,62 @@ var (
 				Padding(0, 1).
 				Margin(0, 0, 1, 0)
 
+	BlockedTodoStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("244")).
+				Faint(true).
+				Padding(0, 1).
+				Margin(0, 0, 1, 0)
+
 	CheckboxStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("42"))
 
+	InProgressCheckboxStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("39"))
+
+	WaitingCheckboxStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("214"))
+
+	CancelledCheckboxStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("160"))
+
 	UncheckboxStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("240"))
 
+	HighPriorityStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("196")).
+				Bold(true)
+
+	MediumPriorityStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("214"))
+
+	LowPriorityStyle = lipgloss.NewStyle().
+				Foreground(lipgloss.Color("33"))
+
+	TagStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("37"))
+
+	DueDateStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("245"))
+
+	DueTodayStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("214")).
+			Bold(true)
+
+	OverdueStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("196")).
+			Bold(true)
+
+	RecurrenceStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("141"))
+
+	BlockerStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("203")).
+			Italic(true)
+
+	DeletedAtStyle = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("240")).
+			Italic(true)
+
 	InputStyle = lipgloss.NewStyle().
 			Border(lipgloss.NormalBorder()).
 			BorderForeground(lipgloss.Color("62")).
@@ -113,18 +168,39 @@ var (
 					Bold(true)
 )
 
-func FormatTodoItem(title string, done bool, selected bool) string {
-	var checkbox string
+func FormatTodoItem(todo *todoApp.ListTodoUsecaseOutputDto, selected bool, collapsed bool) string {
 	style := TodoItemStyle
-
-	if done {
-		checkbox = CheckboxStyle.Render("[✓]")
+	checkbox := FormatStatus(todo.Status)
+	if todo.Status == "done" || todo.Status == "cancelled" {
 		style = CompletedTodoStyle
-	} else {
-		checkbox = UncheckboxStyle.Render("[ ]")
+	}
+	if len(todo.Blockers) > 0 {
+		style = BlockedTodoStyle
 	}
 
-	text := checkbox + " " + title
+	text := strings.Repeat("  ", todo.Depth) + FormatFoldMarker(todo.Subtasks, collapsed) + checkbox + " "
+	if priority := FormatPriority(todo.Priority); priority != "" {
+		text += priority + " "
+	}
+	text += todo.Title
+	if progress := FormatProgress(todo.Completed, todo.Subtasks); progress != "" {
+		text += " " + progress
+	}
+	if todo.Notes != "" {
+		text += " ✎"
+	}
+	if tags := FormatTags(todo.Tags); tags != "" {
+		text += " " + tags
+	}
+	if dueDate := FormatDueDate(todo.DueDate, todo.Overdue, todo.DueToday); dueDate != "" {
+		text += " " + dueDate
+	}
+	if todo.Recurrence != "" {
+		text += " " + RecurrenceStyle.Render("↻ "+todo.Recurrence)
+	}
+	if len(todo.Blockers) > 0 {
+		text += " " + BlockerStyle.Render("⛔ blocked by "+strings.Join(todo.Blockers, ", "))
+	}
 
 	if selected {
 		style = style.Background(lipgloss.Color("62"))
@@ -133,6 +209,132 @@ func FormatTodoItem(title string, done bool, selected bool) string {
 	return style.Render(text)
 }
 
+func FormatTrashItem(todo *todoApp.ListTrashUsecaseOutputDto, selected bool) string {
+	style := TodoItemStyle
+
+	checkbox := FormatStatus(todo.Status)
+
+	text := checkbox + " "
+	if priority := FormatPriority(todo.Priority); priority != "" {
+		text += priority + " "
+	}
+	text += todo.Title
+	if tags := FormatTags(todo.Tags); tags != "" {
+		text += " " + tags
+	}
+	text += " " + DeletedAtStyle.Render("deleted "+todo.DeletedAt)
+
+	if selected {
+		style = style.Background(lipgloss.Color("62"))
+	}
+
+	return style.Render(text)
+}
+
+func FormatArchiveItem(todo *todoApp.ListArchiveUsecaseOutputDto, selected bool) string {
+	style := CompletedTodoStyle
+
+	text := FormatStatus(todo.Status) + " "
+	if priority := FormatPriority(todo.Priority); priority != "" {
+		text += priority + " "
+	}
+	text += todo.Title
+	if tags := FormatTags(todo.Tags); tags != "" {
+		text += " " + tags
+	}
+	if todo.CompletedAt != "" {
+		closed := "completed "
+		if todo.Status == "cancelled" {
+			closed = "cancelled "
+		}
+		text += " " + DeletedAtStyle.Render(closed+todo.CompletedAt)
+	}
+
+	if selected {
+		style = style.Background(lipgloss.Color("62"))
+	}
+
+	return style.Render(text)
+}
+
+func FormatStatus(status string) string {
+	checkbox := statusCheckbox(status)
+	switch status {
+	case "in_progress":
+		return InProgressCheckboxStyle.Render(checkbox)
+	case "waiting":
+		return WaitingCheckboxStyle.Render(checkbox)
+	case "done":
+		return CheckboxStyle.Render(checkbox)
+	case "cancelled":
+		return CancelledCheckboxStyle.Render(checkbox)
+	default:
+		return UncheckboxStyle.Render(checkbox)
+	}
+}
+
+func FormatSort(sort string) string {
+	if sort == "" {
+		return "file order"
+	}
+	return sort
+}
+
+func FormatFoldMarker(subtasks int, collapsed bool) string {
+	switch {
+	case subtasks == 0:
+		return "  "
+	case collapsed:
+		return "▸ "
+	default:
+		return "▾ "
+	}
+}
+
+func FormatProgress(completed int, subtasks int) string {
+	if subtasks == 0 {
+		return ""
+	}
+	progress := fmt.Sprintf("(%d/%d)", completed, subtasks)
+	if completed == subtasks {
+		return CheckboxStyle.Render(progress)
+	}
+	return DueDateStyle.Render(progress)
+}
+
+func FormatPriority(priority string) string {
+	switch priority {
+	case "high":
+		return HighPriorityStyle.Render("!!!")
+	case "medium":
+		return MediumPriorityStyle.Render("!!")
+	case "low":
+		return LowPriorityStyle.Render("!")
+	default:
+		return ""
+	}
+}
+
+func FormatTags(tags []string) string {
+	if len(tags) == 0 {
+		return ""
+	}
+	return TagStyle.Render("+" + strings.Join(tags, " +"))
+}
+
+func FormatDueDate(dueDate string, overdue bool, dueToday bool) string {
+	switch {
+	case dueDate == "":
+		return ""
+	case overdue:
+		return OverdueStyle.Render("⚠ overdue " + dueDate)
+	case dueToday:
+		return DueTodayStyle.Render("● due today")
+	default:
+		return DueDateStyle.Render("due " + dueDate)
+	}
+}
+
 func FormatHeader(text string) string {
 	return HeaderStyle.Render(text)
 }
@@ -161,12 +363,8 @@ func FormatDanger(text string) string {
 	return DangerStyle.Render(text)
 }
 
-func FormatHighlightedTodo(title string, done bool) string {
-	checkbox := "[ ]"
-	if done {
-		checkbox = "[✓]"
-	}
-	text := checkbox + " " + title
+func FormatHighlightedTodo(title string, status string) string {
+	text := statusCheckbox(status) + " " + title
 	return HighlightedTodoStyle.Render(text)
 }
 
@@ -183,3 +381,18 @@ func FormatCancelButton(text string, selected bool) string {
 	}
 	return CancelButtonStyle.Render(text)
 }
+
+func statusCheckbox(status string) string {
+	switch status {
+	case "in_progress":
+		return "[▶]"
+	case "waiting":
+		return "[…]"
+	case "done":
+		return "[✓]"
+	case "cancelled":
+		return "[✗]"
+	default:
+		return "[ ]"
+	}
+}
@@ -18,8 +18,22 @@ Available Operations:
   ↑/k         Move cursor up
   ↓/j         Move cursor down
   enter/space Toggle todo status
+  i           Mark selected todo as in progress (again to reopen)
+  w           Mark selected todo as waiting (again to reopen)
+  c           Cancel selected todo (again to reopen)
+  /           Filter todos with a query, same as gct list [query] (esc clears)
+  n/N         Jump to the next / previous match
+  s           Cycle sort orders (starts with GCT_DEFAULT_SORT)
+  tab         Collapse or expand subtasks
+  ←/h →/l     Collapse / expand subtasks
   a           Add a new todo
-  d           Delete selected todo
+  e           Edit selected todo
+  p           Cycle priority of selected todo
+  d           Move selected todo to the trash
+  t           Show the trash (enter restores)
+  A           Show archived todos (read-only)
+  u           Undo last change
+  ctrl+r      Redo last undone change
   r           Refresh todo list
   q           Quit application
 
@@ -31,7 +45,7 @@ var (
 	envconfig = proxy.NewEnvconfig()
 	json      = proxy.NewJson()
 	os        = proxy.NewOs()
-	fileutil  = utility.NewFileUtil(os, proxy.NewJson())
+	fileutil  = utility.NewFileUtil(os, proxy.NewJson(), proxy.NewFlock())
 )
 
 func main() {
@@ -3,7 +3,17 @@ package model
 import todoApp "github.com/yanosea/gct/app/application/gct"
 
 type TodosLoadedMsg struct {
-	Todos []*todoApp.ListTodoUsecaseOutputDto
+	Todos   []*todoApp.ListTodoUsecaseOutputDto
+	Filter  string
+	Matches []*todoApp.ListTodoUsecaseOutputDto
+}
+
+type TrashLoadedMsg struct {
+	Todos []*todoApp.ListTrashUsecaseOutputDto
+}
+
+type ArchiveLoadedMsg struct {
+	Todos []*todoApp.ListArchiveUsecaseOutputDto
 }
 
 type ErrorMsg struct {
@@ -15,10 +15,17 @@ type Model struct {
 }
 
 type Usecases struct {
-	List   *todoApp.ListTodoUseCase
-	Add    *todoApp.AddTodoUseCase
-	Delete *todoApp.DeleteTodoUseCase
-	Toggle *todoApp.ToggleTodoUseCase
+	List    *todoApp.ListTodoUseCase
+	Add     *todoApp.AddTodoUseCase
+	Edit    *todoApp.EditTodoUseCase
+	Delete  *todoApp.DeleteTodoUseCase
+	Toggle  *todoApp.ToggleTodoUseCase
+	Status  *todoApp.ChangeTodoStatusUseCase
+	Undo    *todoApp.UndoTodoUseCase
+	Redo    *todoApp.RedoTodoUseCase
+	Trash   *todoApp.ListTrashUseCase
+	Restore *todoApp.RestoreTodoUseCase
+	Archive *todoApp.ListArchiveUseCase
 }
 
 func NewModel(usecases *Usecases) *Model {
@@ -63,6 +70,19 @@ func (m *Model) updateModel(msg proxy.Msg) (*Model, proxy.Cmd) {
 	switch msg := msg.(type) {
 	case TodosLoadedMsg:
 		state.SetTodos(msg.Todos)
+		if msg.Matches != nil {
+			state.SetMatches(msg.Filter, msg.Matches)
+		}
+		state.SetError("")
+		return m, nil
+
+	case TrashLoadedMsg:
+		state.SetTrash(msg.Todos)
+		state.SetError("")
+		return m, nil
+
+	case ArchiveLoadedMsg:
+		state.SetArchive(msg.Todos)
 		state.SetError("")
 		return m, nil
 
@@ -73,6 +93,9 @@ func (m *Model) updateModel(msg proxy.Msg) (*Model, proxy.Cmd) {
 	case SuccessMsg:
 		state.SetMessage(msg.Message)
 		state.SetError("")
+		if state.Mode() == ModeTrash {
+			return m, m.loadTrash()
+		}
 		return m, m.loadTodos()
 
 	default:
@@ -88,6 +111,14 @@ func (m *Model) handleKeyPress(keyMsg proxy.KeyMsg) (*Model, proxy.Cmd) {
 		return m.handleAddMode(keyMsg)
 	case ModeDelete:
 		return m.handleDeleteMode(keyMsg)
+	case ModeEdit:
+		return m.handleEditMode(keyMsg)
+	case ModeTrash:
+		return m.handleTrashMode(keyMsg)
+	case ModeArchive:
+		return m.handleArchiveMode(keyMsg)
+	case ModeSearch:
+		return m.handleSearchMode(keyMsg)
 	default:
 		return m, nil
 	}
@@ -107,11 +138,70 @@ func (m *Model) handleListMode(keyMsg proxy.KeyMsg) (*Model, proxy.Cmd) {
 	case "down", "j":
 		state.MoveCursorDown()
 
+	case "/":
+		state.SetMode(ModeSearch)
+		state.ClearMessages()
+
+	case "n":
+		state.NextMatch()
+
+	case "N":
+		state.PrevMatch()
+
+	case "esc":
+		state.ClearFilter()
+
 	case "enter", " ":
 		if todo := state.CurrentTodo(); todo != nil {
 			return m, m.toggleTodo(todo.ID)
 		}
 
+	case "i":
+		if todo := state.CurrentTodo(); todo != nil {
+			return m, m.changeStatus(todo, "in_progress")
+		}
+
+	case "w":
+		if todo := state.CurrentTodo(); todo != nil {
+			return m, m.changeStatus(todo, "waiting")
+		}
+
+	case "c":
+		if todo := state.CurrentTodo(); todo != nil {
+			return m, m.changeStatus(todo, "cancelled")
+		}
+
+	case "tab":
+		if todo := state.CurrentTodo(); todo != nil && todo.Subtasks > 0 {
+			state.SetCollapsed(todo.ID, !state.IsCollapsed(todo.ID))
+		}
+
+	case "left", "h":
+		if todo := state.CurrentTodo(); todo != nil {
+			if todo.Subtasks > 0 && !state.IsCollapsed(todo.ID) {
+				state.SetCollapsed(todo.ID, true)
+			} else if todo.ParentID != "" {
+				state.SelectTodo(todo.ParentID)
+			}
+		}
+
+	case "right", "l":
+		if todo := state.CurrentTodo(); todo != nil && todo.Subtasks > 0 {
+			state.SetCollapsed(todo.ID, false)
+		}
+
+	case "e":
+		if todo := state.CurrentTodo(); todo != nil {
+			state.SetMode(ModeEdit)
+			state.SetInput(strings.Join(append([]string{todo.Title}, prefixTags(todo.Tags)...), " "))
+			state.ClearMessages()
+		}
+
+	case "p":
+		if todo := state.CurrentTodo(); todo != nil {
+			return m, m.cyclePriority(todo.ID, todo.Priority)
+		}
+
 	case "a":
 		state.SetMode(ModeAdd)
 		state.ResetInput()
@@ -124,6 +214,27 @@ func (m *Model) handleListMode(keyMsg proxy.KeyMsg) (*Model, proxy.Cmd) {
 			state.ClearMessages()
 		}
 
+	case "t":
+		state.SetMode(ModeTrash)
+		state.ClearMessages()
+		return m, m.loadTrash()
+
+	case "A":
+		state.SetMode(ModeArchive)
+		state.ClearMessages()
+		return m, m.loadArchive()
+
+	case "u":
+		return m, m.undoTodo()
+
+	case "ctrl+r":
+		return m, m.redoTodo()
+
+	case "s":
+		state.CycleSort()
+		state.SetMessage("Sorted by " + formatter.FormatSort(state.Sort()))
+		return m, m.loadTodos()
+
 	case "r":
 		return m, m.loadTodos()
 	}
@@ -131,6 +242,92 @@ func (m *Model) handleListMode(keyMsg proxy.KeyMsg) (*Model, proxy.Cmd) {
 	return m, nil
 }
 
+func (m *Model) handleTrashMode(keyMsg proxy.KeyMsg) (*Model, proxy.Cmd) {
+	state := m.state
+
+	switch keyMsg.String() {
+	case "ctrl+c", "q":
+		state.SetQuitting(true)
+		return m, proxy.Quit()
+
+	case "up", "k":
+		state.MoveTrashCursorUp()
+
+	case "down", "j":
+		state.MoveTrashCursorDown()
+
+	case "enter", " ":
+		if todo := state.CurrentTrashedTodo(); todo != nil {
+			return m, m.restoreTodo(todo.ID)
+		}
+
+	case "esc", "t":
+		state.SetMode(ModeList)
+		state.ClearMessages()
+		return m, m.loadTodos()
+	}
+
+	return m, nil
+}
+
+func (m *Model) handleArchiveMode(keyMsg proxy.KeyMsg) (*Model, proxy.Cmd) {
+	state := m.state
+
+	switch keyMsg.String() {
+	case "ctrl+c", "q":
+		state.SetQuitting(true)
+		return m, proxy.Quit()
+
+	case "up", "k":
+		state.MoveArchiveCursorUp()
+
+	case "down", "j":
+		state.MoveArchiveCursorDown()
+
+	case "esc", "A":
+		state.SetMode(ModeList)
+		state.ClearMessages()
+	}
+
+	return m, nil
+}
+
+func (m *Model) handleSearchMode(keyMsg proxy.KeyMsg) (*Model, proxy.Cmd) {
+	state := m.state
+
+	switch keyMsg.String() {
+	case "ctrl+c":
+		state.SetQuitting(true)
+		return m, proxy.Quit()
+
+	case "esc":
+		state.SetMode(ModeList)
+		state.ClearFilter()
+
+	case "enter":
+		state.SetMode(ModeList)
+		state.KeepFilter()
+
+	case "up":
+		state.MoveCursorUp()
+
+	case "down":
+		state.MoveCursorDown()
+
+	case "backspace":
+		state.BackspaceFilter()
+		return m, m.loadTodos()
+
+	default:
+		if runes := keyMsg.Runes(); len(runes) > 0 {
+			state.AppendToFilter(string(runes))
+			return m, m.loadTodos()
+		}
+	}
+
+	return m, nil
+}
+
 func (m *Model) handleAddMode(keyMsg proxy.KeyMsg) (*Model, proxy.Cmd) {
 	state := m.state
 
@@ -156,7 +353,42 @@ func (m *Model) handleAddMode(keyMsg proxy.KeyMsg) (*Model, proxy.Cmd) {
 		state.Backspace()
 
 	default:
-		state.AppendToInput(keyMsg.String())
+		if runes := keyMsg.Runes(); len(runes) > 0 {
+			state.AppendToInput(string(runes))
+		}
+	}
+
+	return m, nil
+}
+
+func (m *Model) handleEditMode(keyMsg proxy.KeyMsg) (*Model, proxy.Cmd) {
+	state := m.state
+
+	switch keyMsg.String() {
+	case "ctrl+c":
+		state.SetQuitting(true)
+		return m, proxy.Quit()
+
+	case "esc":
+		state.SetMode(ModeList)
+		state.ResetInput()
+		state.ClearMessages()
+
+	case "enter":
+		if todo := state.CurrentTodo(); todo != nil && len(state.Input()) > 0 {
+			cmd := m.editTodo(todo.ID, state.Input())
+			state.SetMode(ModeList)
+			state.ResetInput()
+			return m, cmd
+		}
+
+	case "backspace":
+		state.Backspace()
+
+	default:
+		if runes := keyMsg.Runes(); len(runes) > 0 {
+			state.AppendToInput(string(runes))
+		}
 	}
 
 	return m, nil
@@ -207,18 +439,44 @@ func (m *Model) handleDeleteMode(keyMsg proxy.KeyMsg) (*Model, proxy.Cmd) {
 }
 
 func (m *Model) loadTodos() proxy.Cmd {
+	sort, filter := m.state.Sort(), m.state.Filter()
+	return func() proxy.Msg {
+		output, err := m.usecases.List.Run(&todoApp.ListTodoUsecaseInputDto{Tree: true, Sort: sort})
+		if err != nil {
+			return ErrorMsg{Error: err.Error()}
+		}
+		if filter == "" {
+			return TodosLoadedMsg{Todos: output}
+		}
+		// a filter that does not parse yet, like an open quote, keeps the previous matches
+		matches, _ := m.usecases.List.Run(&todoApp.ListTodoUsecaseInputDto{Tree: true, Sort: sort, Query: filter})
+		return TodosLoadedMsg{Todos: output, Filter: filter, Matches: matches}
+	}
+}
+
+func (m *Model) loadTrash() proxy.Cmd {
 	return func() proxy.Msg {
-		output, err := m.usecases.List.Run()
+		output, err := m.usecases.Trash.Run()
 		if err != nil {
 			return ErrorMsg{Error: err.Error()}
 		}
-		return TodosLoadedMsg{Todos: output}
+		return TrashLoadedMsg{Todos: output}
+	}
+}
+
+func (m *Model) loadArchive() proxy.Cmd {
+	return func() proxy.Msg {
+		output, err := m.usecases.Archive.Run()
+		if err != nil {
+			return ErrorMsg{Error: err.Error()}
+		}
+		return ArchiveLoadedMsg{Todos: output}
 	}
 }
 
 func (m *Model) addTodo(title string) proxy.Cmd {
 	return func() proxy.Msg {
-		_, err := m.usecases.Add.Run(title)
+		_, err := m.usecases.Add.Run(&todoApp.AddTodoUsecaseInputDto{Title: title})
 		if err != nil {
 			return ErrorMsg{Error: err.Error()}
 		}
@@ -226,19 +484,44 @@ func (m *Model) addTodo(title string) proxy.Cmd {
 	}
 }
 
+func (m *Model) editTodo(id string, input string) proxy.Cmd {
+	return func() proxy.Msg {
+		tags := []string{}
+		output, err := m.usecases.Edit.Run(&todoApp.EditTodoUsecaseInputDto{
+			ID:    id,
+			Title: &input,
+			Tags:  &tags,
+		})
+		if err != nil {
+			return ErrorMsg{Error: err.Error()}
+		}
+		return SuccessMsg{Message: fmt.Sprintf("Edited todo: %s", output.Title)}
+	}
+}
+
 func (m *Model) deleteTodo(id string) proxy.Cmd {
 	return func() proxy.Msg {
 		output, err := m.usecases.Delete.Run(id)
 		if err != nil {
 			return ErrorMsg{Error: err.Error()}
 		}
-		return SuccessMsg{Message: fmt.Sprintf("Deleted todo: %s", output.Title)}
+		return SuccessMsg{Message: fmt.Sprintf("Moved todo to trash: %s", output.Title)}
+	}
+}
+
+func (m *Model) restoreTodo(id string) proxy.Cmd {
+	return func() proxy.Msg {
+		output, err := m.usecases.Restore.Run(id)
+		if err != nil {
+			return ErrorMsg{Error: err.Error()}
+		}
+		return SuccessMsg{Message: fmt.Sprintf("Restored todo: %s", output.Title)}
 	}
 }
 
 func (m *Model) toggleTodo(id string) proxy.Cmd {
 	return func() proxy.Msg {
-		output, err := m.usecases.Toggle.Run(id)
+		output, err := m.usecases.Toggle.Run(&todoApp.ToggleTodoUsecaseInputDto{ID: id})
 		if err != nil {
 			return ErrorMsg{Error: err.Error()}
 		}
@@ -248,10 +531,82 @@ func (m *Model) toggleTodo(id string) proxy.Cmd {
 			status = "complete"
 		}
 
+		if output.NextDueDate != "" {
+			return SuccessMsg{Message: fmt.Sprintf("Marked todo as %s: %s (next due %s)", status, output.Title, output.NextDueDate)}
+		}
 		return SuccessMsg{Message: fmt.Sprintf("Marked todo as %s: %s", status, output.Title)}
 	}
 }
 
+func (m *Model) changeStatus(todo *todoApp.ListTodoUsecaseOutputDto, status string) proxy.Cmd {
+	if todo.Status == status {
+		status = "todo"
+	}
+	return func() proxy.Msg {
+		output, err := m.usecases.Status.Run(&todoApp.ChangeTodoStatusUsecaseInputDto{ID: todo.ID, Status: status})
+		if err != nil {
+			return ErrorMsg{Error: err.Error()}
+		}
+		if output.Status == "todo" {
+			return SuccessMsg{Message: fmt.Sprintf("Reopened todo: %s", output.Title)}
+		}
+		return SuccessMsg{Message: fmt.Sprintf("Marked todo as %s: %s", strings.ReplaceAll(output.Status, "_", " "), output.Title)}
+	}
+}
+
+func (m *Model) undoTodo() proxy.Cmd {
+	return func() proxy.Msg {
+		output, err := m.usecases.Undo.Run()
+		if err != nil {
+			return ErrorMsg{Error: err.Error()}
+		}
+		return SuccessMsg{Message: fmt.Sprintf("Undid %s: %s", output.Operation, output.Title)}
+	}
+}
+
+func (m *Model) redoTodo() proxy.Cmd {
+	return func() proxy.Msg {
+		output, err := m.usecases.Redo.Run()
+		if err != nil {
+			return ErrorMsg{Error: err.Error()}
+		}
+		return SuccessMsg{Message: fmt.Sprintf("Redid %s: %s", output.Operation, output.Title)}
+	}
+}
+
+func (m *Model) cyclePriority(id string, current string) proxy.Cmd {
+	next := map[string]string{
+		"":       "low",
+		"low":    "medium",
+		"medium": "high",
+		"high":   "none",
+	}[current]
+	return func() proxy.Msg {
+		output, err := m.usecases.Edit.Run(&todoApp.EditTodoUsecaseInputDto{
+			ID:       id,
+			Priority: &next,
+		})
+		if err != nil {
+			return ErrorMsg{Error: err.Error()}
+		}
+
+		priority := output.Priority
+		if priority == "" {
+			priority = "none"
+		}
+
+		return SuccessMsg{Message: fmt.Sprintf("Set priority to %s: %s", priority, output.Title)}
+	}
+}
+
+func prefixTags(tags []string) []string {
+	prefixed := make([]string, len(tags))
+	for i, tag := range tags {
+		prefixed[i] = "+" + tag
+	}
+	return prefixed
+}
+
 func (m *Model) renderView() string {
 	state := m.state
 
@@ -273,12 +628,18 @@ func (m *Model) renderView() string {
 	}
 
 	switch state.Mode() {
-	case ModeList:
+	case ModeList, ModeSearch:
 		content.WriteString(m.renderListView())
 	case ModeAdd:
 		content.WriteString(m.renderAddView())
 	case ModeDelete:
 		content.WriteString(m.renderDeleteView())
+	case ModeEdit:
+		content.WriteString(m.renderEditView())
+	case ModeTrash:
+		content.WriteString(m.renderTrashView())
+	case ModeArchive:
+		content.WriteString(m.renderArchiveView())
 	}
 
 	content.WriteString("\n" + m.renderHelpView())
@@ -293,12 +654,19 @@ func (m *Model) renderListView() string {
 	var content strings.Builder
 	state := m.state
 
-	if len(state.Todos()) == 0 {
+	if state.Mode() == ModeSearch {
+		content.WriteString(formatter.FormatInput("/"+state.Filter()) + "\n\n")
+	} else if state.Filter() != "" {
+		content.WriteString(formatter.FormatHelp(fmt.Sprintf("search: %s (%d matches)", state.Filter(), state.MatchCount())) + "\n")
+	}
+	if len(state.VisibleTodos()) == 0 && state.IsFiltered() {
+		content.WriteString("No matching todos. Press 'esc' to clear the filter.\n")
+	} else if len(state.VisibleTodos()) == 0 {
 		content.WriteString("No todos found. Press 'a' to add a new todo.\n")
 	} else {
-		for i, todo := range state.Todos() {
+		for i, todo := range state.VisibleTodos() {
 			selected := i == state.Cursor()
-			todoItem := formatter.FormatTodoItem(todo.Title, todo.Done, selected)
+			todoItem := formatter.FormatTodoItem(todo, selected, state.IsCollapsed(todo.ID))
 			content.WriteString(todoItem + "\n")
 		}
 	}
@@ -306,10 +674,53 @@ func (m *Model) renderListView() string {
 	return content.String()
 }
 
+func (m *Model) renderTrashView() string {
+	var content strings.Builder
+	state := m.state
+
+	content.WriteString("🗑️ Trash\n\n")
+	if len(state.Trash()) == 0 {
+		content.WriteString("Trash is empty. Press 't' to go back.\n")
+	} else {
+		for i, todo := range state.Trash() {
+			selected := i == state.TrashCursor()
+			content.WriteString(formatter.FormatTrashItem(todo, selected) + "\n")
+		}
+	}
+
+	return content.String()
+}
+
+func (m *Model) renderArchiveView() string {
+	var content strings.Builder
+	state := m.state
+
+	content.WriteString("📦 Archive (read-only)\n\n")
+	if len(state.Archive()) == 0 {
+		content.WriteString("Archive is empty. Run 'gct archive' to archive completed todos.\n")
+	} else {
+		for i, todo := range state.Archive() {
+			selected := i == state.ArchiveCursor()
+			content.WriteString(formatter.FormatArchiveItem(todo, selected) + "\n")
+		}
+	}
+
+	return content.String()
+}
+
 func (m *Model) renderAddView() string {
 	state := m.state
 
-	return fmt.Sprintf(`Add a new todo:
+	return fmt.Sprintf(`Add a new todo (use +tag to attach tags):
+
+%s
+`, formatter.FormatInput(state.Input()))
+}
+
+func (m *Model) renderEditView() string {
+	state := m.state
+
+	return fmt.Sprintf(`Edit todo (use +tag to set tags):
 
 %s
 `, formatter.FormatInput(state.Input()))
@@ -327,13 +738,13 @@ func (m *Model) renderDeleteView() string {
 
 	questionText := "Are you sure you want to delete this todo?"
 
-	highlightedTodo := formatter.FormatHighlightedTodo(todo.Title, todo.Done)
+	highlightedTodo := formatter.FormatHighlightedTodo(todo.Title, todo.Status)
 
 	confirmButton := formatter.FormatConfirmButton("YES, DELETE", state.ConfirmButtonSelected())
 	cancelButton := formatter.FormatCancelButton("NO, CANCEL", !state.ConfirmButtonSelected())
 	buttonsRow := confirmButton + " " + cancelButton
 
-	instructions := `This action cannot be undone!
+	instructions := `Deleted todos go to the trash (t) and can be restored.
 
 ← → or TAB or h/l: Switch between buttons
 ENTER: Execute selected action
@@ -354,9 +765,17 @@ ESC: Cancel deletion`
 func (m *Model) renderHelpView() string {
 	switch m.state.Mode() {
 	case ModeList:
-		return formatter.FormatHelp("↑/k: up • ↓/j: down • enter/space: toggle • a: add • d: delete • r: refresh • q: quit")
+		return formatter.FormatHelp("↑/k: up • ↓/j: down • enter/space: toggle • i/w/c: start/wait/cancel • /: filter • n/N: next/prev match • esc: clear search • s: sort • tab/←→: fold • a: add • e: edit • p: priority • d: delete • t: trash • A: archive • u: undo • ctrl+r: redo • r: refresh • q: quit")
+	case ModeSearch:
+		return formatter.FormatHelp("type a query (tag:work due<=today \"phrase\" -word) • ↑/↓: move • enter: search the full list • esc: clear filter • ctrl+c: quit")
 	case ModeAdd:
 		return formatter.FormatHelp("enter: add todo • esc: cancel • ctrl+c: quit")
+	case ModeEdit:
+		return formatter.FormatHelp("enter: save todo • esc: cancel • ctrl+c: quit")
+	case ModeTrash:
+		return formatter.FormatHelp("↑/k: up • ↓/j: down • enter/space: restore • esc/t: back • q: quit")
+	case ModeArchive:
+		return formatter.FormatHelp("↑/k: up • ↓/j: down • esc/A: back • q: quit")
 	case ModeDelete:
 		return formatter.FormatHelp("←→/tab/h/l: switch buttons • enter: execute • y: quick confirm • n/esc: cancel • ctrl+c: quit")
 	default:
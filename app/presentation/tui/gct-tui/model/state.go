@@ -1,6 +1,10 @@
 package model
 
-import todoApp "github.com/yanosea/gct/app/application/gct"
+import (
+	"slices"
+
+	todoApp "github.com/yanosea/gct/app/application/gct"
+)
 
 type Mode int
 
@@ -8,19 +12,36 @@ const (
 	ModeList Mode = iota
 	ModeAdd
 	ModeDelete
+	ModeEdit
+	ModeTrash
+	ModeArchive
+	ModeSearch
 )
 
+var sortPresets = []string{"", "created:desc", "title", "priority:desc,created", "due", "status"}
+
 type State struct {
-	mode     Mode
-	cursor   int
-	input    string
-	width    int
-	height   int
-	quitting bool
+	mode          Mode
+	cursor        int
+	trashCursor   int
+	archiveCursor int
+	input         string
+	width         int
+	height        int
+	quitting      bool
 
-	todos   []*todoApp.ListTodoUsecaseOutputDto
-	error   string
-	message string
+	todos     []*todoApp.ListTodoUsecaseOutputDto
+	visible   []*todoApp.ListTodoUsecaseOutputDto
+	collapsed map[string]bool
+	filter    string
+	matches   []*todoApp.ListTodoUsecaseOutputDto
+	narrowed  bool
+	sorts     []string
+	sortIndex int
+	error     string
+	trash     []*todoApp.ListTrashUsecaseOutputDto
+	archive   []*todoApp.ListArchiveUsecaseOutputDto
+	message   string
 
 	confirmButtonSelected bool
 }
@@ -34,6 +55,11 @@ func NewState() *State {
 		height:                24,
 		quitting:              false,
 		todos:                 make([]*todoApp.ListTodoUsecaseOutputDto, 0),
+		visible:               make([]*todoApp.ListTodoUsecaseOutputDto, 0),
+		collapsed:             make(map[string]bool),
+		sorts:                 sortPresets,
+		trash:                 make([]*todoApp.ListTrashUsecaseOutputDto, 0),
+		archive:               make([]*todoApp.ListArchiveUsecaseOutputDto, 0),
 		error:                 "",
 		message:               "",
 		confirmButtonSelected: false,
@@ -51,7 +77,7 @@ func (s *State) MoveCursorUp() {
 	}
 }
 func (s *State) MoveCursorDown() {
-	if s.cursor < len(s.todos)-1 {
+	if s.cursor < len(s.visible)-1 {
 		s.cursor++
 	}
 }
@@ -61,8 +87,8 @@ func (s *State) SetInput(input string)     { s.input = input }
 func (s *State) ResetInput()               { s.input = "" }
 func (s *State) AppendToInput(text string) { s.input += text }
 func (s *State) Backspace() {
-	if len(s.input) > 0 {
-		s.input = s.input[:len(s.input)-1]
+	if runes := []rune(s.input); len(runes) > 0 {
+		s.input = string(runes[:len(runes)-1])
 	}
 }
 
@@ -76,15 +102,188 @@ func (s *State) SetDimensions(width, height int) {
 func (s *State) Quitting() bool            { return s.quitting }
 func (s *State) SetQuitting(quitting bool) { s.quitting = quitting }
 
-func (s *State) Todos() []*todoApp.ListTodoUsecaseOutputDto         { return s.todos }
-func (s *State) SetTodos(todos []*todoApp.ListTodoUsecaseOutputDto) { s.todos = todos }
+func (s *State) Todos() []*todoApp.ListTodoUsecaseOutputDto        { return s.todos }
+func (s *State) VisibleTodos() []*todoApp.ListTodoUsecaseOutputDto { return s.visible }
+func (s *State) SetTodos(todos []*todoApp.ListTodoUsecaseOutputDto) {
+	s.todos = todos
+	s.refreshVisible()
+}
 func (s *State) CurrentTodo() *todoApp.ListTodoUsecaseOutputDto {
-	if s.cursor >= 0 && s.cursor < len(s.todos) {
-		return s.todos[s.cursor]
+	if s.cursor >= 0 && s.cursor < len(s.visible) {
+		return s.visible[s.cursor]
+	}
+	return nil
+}
+
+func (s *State) IsCollapsed(id string) bool { return s.collapsed[id] }
+func (s *State) SetCollapsed(id string, collapsed bool) {
+	if collapsed {
+		s.collapsed[id] = true
+	} else {
+		delete(s.collapsed, id)
+	}
+	s.refreshKeepingCursor()
+}
+func (s *State) SelectTodo(id string) {
+	for i, todo := range s.visible {
+		if todo.ID == id {
+			s.cursor = i
+			return
+		}
+	}
+}
+
+func (s *State) Filter() string   { return s.filter }
+func (s *State) IsFiltered() bool { return s.narrowed && s.matches != nil }
+func (s *State) MatchCount() int  { return len(s.matches) }
+func (s *State) SetFilter(filter string) {
+	s.filter = filter
+	s.narrowed = filter != ""
+	if filter == "" {
+		s.matches = nil
+	}
+	s.refreshKeepingCursor()
+}
+func (s *State) SetMatches(filter string, matches []*todoApp.ListTodoUsecaseOutputDto) {
+	if filter != s.filter {
+		return
+	}
+	s.matches = matches
+	s.refreshKeepingCursor()
+}
+func (s *State) AppendToFilter(text string) { s.SetFilter(s.filter + text) }
+func (s *State) BackspaceFilter() {
+	if runes := []rune(s.filter); len(runes) > 0 {
+		s.SetFilter(string(runes[:len(runes)-1]))
+	}
+}
+func (s *State) ClearFilter() { s.SetFilter("") }
+
+func (s *State) KeepFilter() {
+	if s.filter == "" {
+		return
+	}
+	s.narrowed = false
+	s.refreshKeepingCursor()
+	if current := s.CurrentTodo(); current == nil || !s.isMatch(current.ID) {
+		s.NextMatch()
+	}
+}
+func (s *State) NextMatch() { s.jumpToMatch(1) }
+func (s *State) PrevMatch() { s.jumpToMatch(-1) }
+
+func (s *State) isMatch(id string) bool {
+	return slices.ContainsFunc(s.matches, func(todo *todoApp.ListTodoUsecaseOutputDto) bool { return todo.ID == id })
+}
+
+func (s *State) jumpToMatch(step int) {
+	if len(s.matches) == 0 || len(s.todos) == 0 {
+		return
+	}
+	from := 0
+	if current := s.CurrentTodo(); current != nil {
+		from = max(0, slices.IndexFunc(s.todos, func(todo *todoApp.ListTodoUsecaseOutputDto) bool { return todo.ID == current.ID }))
+	}
+	for i := 1; i <= len(s.todos); i++ {
+		todo := s.todos[((from+step*i)%len(s.todos)+len(s.todos))%len(s.todos)]
+		if !s.isMatch(todo.ID) {
+			continue
+		}
+		for parentID := todo.ParentID; parentID != ""; {
+			delete(s.collapsed, parentID)
+			parent := slices.IndexFunc(s.todos, func(todo *todoApp.ListTodoUsecaseOutputDto) bool { return todo.ID == parentID })
+			if parent < 0 {
+				break
+			}
+			parentID = s.todos[parent].ParentID
+		}
+		s.refreshVisible()
+		s.SelectTodo(todo.ID)
+		return
+	}
+}
+
+func (s *State) Sort() string { return s.sorts[s.sortIndex] }
+func (s *State) SetSort(sort string) {
+	s.sorts = []string{sort}
+	for _, preset := range sortPresets {
+		if preset != sort {
+			s.sorts = append(s.sorts, preset)
+		}
+	}
+	s.sortIndex = 0
+}
+func (s *State) CycleSort() { s.sortIndex = (s.sortIndex + 1) % len(s.sorts) }
+
+func (s *State) refreshKeepingCursor() {
+	current := s.CurrentTodo()
+	s.refreshVisible()
+	if current != nil {
+		s.SelectTodo(current.ID)
+	}
+}
+
+func (s *State) refreshVisible() {
+	s.visible = make([]*todoApp.ListTodoUsecaseOutputDto, 0, len(s.todos))
+	if s.IsFiltered() {
+		s.visible = append(s.visible, s.matches...)
+		s.cursor = max(0, min(s.cursor, len(s.visible)-1))
+		return
+	}
+	hideBelow := -1
+	for _, todo := range s.todos {
+		if hideBelow >= 0 && todo.Depth > hideBelow {
+			continue
+		}
+		hideBelow = -1
+		s.visible = append(s.visible, todo)
+		if s.collapsed[todo.ID] && todo.Subtasks > 0 {
+			hideBelow = todo.Depth
+		}
+	}
+	s.cursor = max(0, min(s.cursor, len(s.visible)-1))
+}
+
+func (s *State) Trash() []*todoApp.ListTrashUsecaseOutputDto { return s.trash }
+func (s *State) SetTrash(todos []*todoApp.ListTrashUsecaseOutputDto) {
+	s.trash = todos
+	s.trashCursor = max(0, min(s.trashCursor, len(todos)-1))
+}
+func (s *State) TrashCursor() int { return s.trashCursor }
+func (s *State) MoveTrashCursorUp() {
+	if s.trashCursor > 0 {
+		s.trashCursor--
+	}
+}
+func (s *State) MoveTrashCursorDown() {
+	if s.trashCursor < len(s.trash)-1 {
+		s.trashCursor++
+	}
+}
+func (s *State) CurrentTrashedTodo() *todoApp.ListTrashUsecaseOutputDto {
+	if s.trashCursor >= 0 && s.trashCursor < len(s.trash) {
+		return s.trash[s.trashCursor]
 	}
 	return nil
 }
 
+func (s *State) Archive() []*todoApp.ListArchiveUsecaseOutputDto { return s.archive }
+func (s *State) SetArchive(todos []*todoApp.ListArchiveUsecaseOutputDto) {
+	s.archive = todos
+	s.archiveCursor = max(0, min(s.archiveCursor, len(todos)-1))
+}
+func (s *State) ArchiveCursor() int { return s.archiveCursor }
+func (s *State) MoveArchiveCursorUp() {
+	if s.archiveCursor > 0 {
+		s.archiveCursor--
+	}
+}
+func (s *State) MoveArchiveCursorDown() {
+	if s.archiveCursor < len(s.archive)-1 {
+		s.archiveCursor++
+	}
+}
+
 func (s *State) Error() string             { return s.error }
 func (s *State) Message() string           { return s.message }
 func (s *State) SetError(error string)     { s.error = error }
@@ -65,6 +65,7 @@ func (p *programProxy) Wait() tea.Model {
 }
 
 type KeyMsg interface {
+	Runes() []rune
 	String() string
 }
 
@@ -72,6 +73,16 @@ type keyMsgProxy struct {
 	tea.KeyMsg
 }
 
+func (k *keyMsgProxy) Runes() []rune {
+	if k.Alt || (k.Type != tea.KeyRunes && k.Type != tea.KeySpace) {
+		return nil
+	}
+	if k.Type == tea.KeySpace {
+		return []rune{' '}
+	}
+	return k.KeyMsg.Runes
+}
+
 func (k *keyMsgProxy) String() string {
 	return k.KeyMsg.String()
 }
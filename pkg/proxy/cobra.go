@@ -9,7 +9,9 @@ import (
 type Cobra interface {
 	ExactArgs(int) PositionalArgs
 	MaximumNArgs(int) PositionalArgs
+	MinimumNArgs(int) PositionalArgs
 	NewCommand() Command
+	RangeArgs(min int, max int) PositionalArgs
 }
 
 type cobraProxy struct{}
@@ -26,10 +28,18 @@ func (*cobraProxy) MaximumNArgs(n int) PositionalArgs {
 	return &positionalArgsProxy{PositionalArgs: cobra.MaximumNArgs(n)}
 }
 
+func (*cobraProxy) MinimumNArgs(n int) PositionalArgs {
+	return &positionalArgsProxy{PositionalArgs: cobra.MinimumNArgs(n)}
+}
+
 func (*cobraProxy) NewCommand() Command {
 	return &commandProxy{Command: &cobra.Command{}}
 }
 
+func (*cobraProxy) RangeArgs(min int, max int) PositionalArgs {
+	return &positionalArgsProxy{PositionalArgs: cobra.RangeArgs(min, max)}
+}
+
 type PositionalArgs interface {
 	GetPositionalArgs() cobra.PositionalArgs
 }
@@ -46,6 +56,7 @@ type Command interface {
 	AddCommand(cmds ...Command)
 	Execute() error
 	GetCommand() *cobra.Command
+	MarkPersistentFlagRequired(name string) error
 	PersistentFlags() FlagSet
 	RunE(cmd *cobra.Command, args []string) error
 	SetArgs(positionalArgs PositionalArgs)
@@ -72,6 +83,10 @@ func (c *commandProxy) Execute() error {
 	return c.Command.Execute()
 }
 
+func (c *commandProxy) MarkPersistentFlagRequired(name string) error {
+	return c.Command.MarkPersistentFlagRequired(name)
+}
+
 func (c *commandProxy) GetCommand() *cobra.Command {
 	return c.Command
 }
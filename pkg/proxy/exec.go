@@ -0,0 +1,47 @@
+package proxy
+
+import (
+	"io"
+	"os/exec"
+)
+
+type Exec interface {
+	Command(name string, arg ...string) ExecCmd
+}
+
+type execProxy struct{}
+
+func NewExec() Exec {
+	return &execProxy{}
+}
+
+func (*execProxy) Command(name string, arg ...string) ExecCmd {
+	return &execCmdProxy{Cmd: exec.Command(name, arg...)}
+}
+
+type ExecCmd interface {
+	Run() error
+	SetStderr(stderr io.Writer)
+	SetStdin(stdin io.Reader)
+	SetStdout(stdout io.Writer)
+}
+
+type execCmdProxy struct {
+	Cmd *exec.Cmd
+}
+
+func (c *execCmdProxy) Run() error {
+	return c.Cmd.Run()
+}
+
+func (c *execCmdProxy) SetStderr(stderr io.Writer) {
+	c.Cmd.Stderr = stderr
+}
+
+func (c *execCmdProxy) SetStdin(stdin io.Reader) {
+	c.Cmd.Stdin = stdin
+}
+
+func (c *execCmdProxy) SetStdout(stdout io.Writer) {
+	c.Cmd.Stdout = stdout
+}
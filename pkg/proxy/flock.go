@@ -0,0 +1,12 @@
+package proxy
+
+type Flock interface {
+	TryLock(file File) (bool, error)
+	Unlock(file File) error
+}
+
+type flockProxy struct{}
+
+func NewFlock() Flock {
+	return &flockProxy{}
+}
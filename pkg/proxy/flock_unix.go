@@ -0,0 +1,22 @@
+//go:build unix
+
+package proxy
+
+import (
+	"errors"
+	"syscall"
+)
+
+func (*flockProxy) TryLock(file File) (bool, error) {
+	if err := syscall.Flock(int(file.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		if errors.Is(err, syscall.EWOULDBLOCK) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func (*flockProxy) Unlock(file File) error {
+	return syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+}
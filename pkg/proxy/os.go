@@ -2,15 +2,24 @@ package proxy
 
 import (
 	"os"
+
+	"github.com/charmbracelet/x/term"
 )
 
 type Os interface {
+	Chmod(name string, mode os.FileMode) error
+	CreateTemp(dir string, pattern string) (File, error)
 	Exit(code int)
 	Getenv(key string) string
 	IsNotExist(err error) bool
 	MkdirAll(path string, perm os.FileMode) error
+	OpenFile(name string, flag int, perm os.FileMode) (File, error)
+	ReadDir(name string) ([]os.DirEntry, error)
 	ReadFile(filename string) ([]byte, error)
+	Remove(name string) error
+	Rename(oldpath string, newpath string) error
 	Stat(name string) (os.FileInfo, error)
+	TerminalWidth() int
 	UserHomeDir() (string, error)
 	WriteFile(filename string, data []byte, perm os.FileMode) error
 }
@@ -21,6 +30,18 @@ func NewOs() Os {
 	return &osProxy{}
 }
 
+func (osProxy) Chmod(name string, mode os.FileMode) error {
+	return os.Chmod(name, mode)
+}
+
+func (osProxy) CreateTemp(dir string, pattern string) (File, error) {
+	file, err := os.CreateTemp(dir, pattern)
+	if err != nil {
+		return nil, err
+	}
+	return &fileProxy{File: file}, nil
+}
+
 func (osProxy) Exit(code int) {
 	os.Exit(code)
 }
@@ -37,14 +58,45 @@ func (osProxy) MkdirAll(path string, perm os.FileMode) error {
 	return os.MkdirAll(path, perm)
 }
 
+func (osProxy) OpenFile(name string, flag int, perm os.FileMode) (File, error) {
+	file, err := os.OpenFile(name, flag, perm)
+	if err != nil {
+		return nil, err
+	}
+	return &fileProxy{File: file}, nil
+}
+
+func (osProxy) ReadDir(name string) ([]os.DirEntry, error) {
+	return os.ReadDir(name)
+}
+
 func (osProxy) ReadFile(filename string) ([]byte, error) {
 	return os.ReadFile(filename)
 }
 
+func (osProxy) Remove(name string) error {
+	return os.Remove(name)
+}
+
+func (osProxy) Rename(oldpath string, newpath string) error {
+	return os.Rename(oldpath, newpath)
+}
+
 func (osProxy) Stat(name string) (os.FileInfo, error) {
 	return os.Stat(name)
 }
 
+func (osProxy) TerminalWidth() int {
+	if !term.IsTerminal(os.Stdout.Fd()) {
+		return 0
+	}
+	width, _, err := term.GetSize(os.Stdout.Fd())
+	if err != nil {
+		return 0
+	}
+	return width
+}
+
 func (osProxy) UserHomeDir() (string, error) {
 	return os.UserHomeDir()
 }
@@ -52,3 +104,35 @@ func (osProxy) UserHomeDir() (string, error) {
 func (osProxy) WriteFile(filename string, data []byte, perm os.FileMode) error {
 	return os.WriteFile(filename, data, perm)
 }
+
+type File interface {
+	Close() error
+	Fd() uintptr
+	Name() string
+	Sync() error
+	Write(b []byte) (int, error)
+}
+
+type fileProxy struct {
+	File *os.File
+}
+
+func (f *fileProxy) Close() error {
+	return f.File.Close()
+}
+
+func (f *fileProxy) Fd() uintptr {
+	return f.File.Fd()
+}
+
+func (f *fileProxy) Name() string {
+	return f.File.Name()
+}
+
+func (f *fileProxy) Sync() error {
+	return f.File.Sync()
+}
+
+func (f *fileProxy) Write(b []byte) (int, error) {
+	return f.File.Write(b)
+}
@@ -5,13 +5,33 @@ import (
 )
 
 type FlagSet interface {
+	BoolVarP(p *bool, name string, shorthand string, value bool, usage string)
+	StringArrayVarP(p *[]string, name string, shorthand string, value []string, usage string)
+	StringSliceVarP(p *[]string, name string, shorthand string, value []string, usage string)
 	StringVarP(p *string, name string, shorthand string, value string, usage string)
+	VarP(value pflag.Value, name string, shorthand string, usage string)
 }
 
 type flagSetProxy struct {
 	FlagSet *pflag.FlagSet
 }
 
+func (f *flagSetProxy) BoolVarP(p *bool, name string, shorthand string, value bool, usage string) {
+	f.FlagSet.BoolVarP(p, name, shorthand, value, usage)
+}
+
+func (f *flagSetProxy) StringArrayVarP(p *[]string, name string, shorthand string, value []string, usage string) {
+	f.FlagSet.StringArrayVarP(p, name, shorthand, value, usage)
+}
+
+func (f *flagSetProxy) StringSliceVarP(p *[]string, name string, shorthand string, value []string, usage string) {
+	f.FlagSet.StringSliceVarP(p, name, shorthand, value, usage)
+}
+
 func (f *flagSetProxy) StringVarP(p *string, name string, shorthand string, value string, usage string) {
 	f.FlagSet.StringVarP(p, name, shorthand, value, usage)
 }
+
+func (f *flagSetProxy) VarP(value pflag.Value, name string, shorthand string, usage string) {
+	f.FlagSet.VarP(value, name, shorthand, usage)
+}
@@ -0,0 +1,2 @@
+// Package todotxt is the todo.txt format package.
+package todotxt
@@ -0,0 +1,139 @@
+package todotxt
+
+import (
+	"maps"
+	"net/url"
+	"strings"
+)
+
+var priorityLetters = map[string]string{
+	"high":   "A",
+	"medium": "B",
+	"low":    "C",
+}
+
+func PriorityLetter(priority string) string {
+	return priorityLetters[priority]
+}
+
+func PriorityName(letter string) string {
+	switch letter {
+	case "":
+		return ""
+	case "A":
+		return "high"
+	case "B":
+		return "medium"
+	default:
+		return "low"
+	}
+}
+
+func SplitTags(tags []string) ([]string, []string) {
+	var projects, contexts []string
+	for _, tag := range tags {
+		if context, ok := strings.CutPrefix(tag, "@"); ok {
+			contexts = append(contexts, context)
+		} else {
+			projects = append(projects, tag)
+		}
+	}
+	return projects, contexts
+}
+
+func (t *Task) Tags() []string {
+	tags := make([]string, 0, len(t.Projects)+len(t.Contexts))
+	tags = append(tags, t.Projects...)
+	for _, context := range t.Contexts {
+		tags = append(tags, "@"+context)
+	}
+	return tags
+}
+
+const (
+	keyPriority   = "pri"
+	keyStatus     = "status"
+	keyDue        = "due"
+	keyRecurrence = "rec"
+	keyNotes      = "note"
+	keyID         = "id"
+	keyParent     = "parent"
+	keyBlockedBy  = "blocked"
+)
+
+// Fields are the gct attributes a task keeps in its key:value tags.
+type Fields struct {
+	Priority   string
+	Status     string
+	Due        string
+	Recurrence string
+	Notes      string
+	ID         string
+	Parent     string
+	BlockedBy  []string
+}
+
+// Fields splits the extras of the task into the fields gct models and the rest, leaving the task as parsed.
+func (t *Task) Fields() (Fields, map[string]string) {
+	extras := maps.Clone(t.Extras)
+	if extras == nil {
+		extras = make(map[string]string)
+	}
+	take := func(key string) string {
+		value := extras[key]
+		delete(extras, key)
+		return value
+	}
+	fields := Fields{
+		Priority:   t.Priority,
+		Status:     take(keyStatus),
+		Due:        take(keyDue),
+		Recurrence: take(keyRecurrence),
+		Notes:      UnescapeNotes(take(keyNotes)),
+		ID:         take(keyID),
+		Parent:     take(keyParent),
+	}
+	// done tasks lose their priority in todo.txt, so it is kept in pri
+	if priority := take(keyPriority); fields.Priority == "" {
+		fields.Priority = priority
+	}
+	if blockedBy := take(keyBlockedBy); blockedBy != "" {
+		fields.BlockedBy = strings.Split(blockedBy, ",")
+	}
+	return fields, extras
+}
+
+// SetFields writes the fields into the task, leaving out the empty ones.
+func (t *Task) SetFields(fields Fields) {
+	if t.Extras == nil {
+		t.Extras = make(map[string]string)
+	}
+	set := func(key, value string) {
+		if value != "" {
+			t.Extras[key] = value
+		}
+	}
+	t.Priority = fields.Priority
+	if t.Done {
+		set(keyPriority, fields.Priority)
+	}
+	set(keyStatus, fields.Status)
+	set(keyDue, fields.Due)
+	set(keyRecurrence, fields.Recurrence)
+	set(keyNotes, EscapeNotes(fields.Notes))
+	set(keyID, fields.ID)
+	set(keyParent, fields.Parent)
+	set(keyBlockedBy, strings.Join(fields.BlockedBy, ","))
+}
+
+// EscapeNotes keeps notes in a single word, since a task is one line and its words are split on spaces.
+func EscapeNotes(notes string) string {
+	return url.PathEscape(notes)
+}
+
+func UnescapeNotes(value string) string {
+	if notes, err := url.PathUnescape(value); err == nil {
+		return notes
+	}
+	return value
+}
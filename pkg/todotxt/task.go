@@ -0,0 +1,196 @@
+package todotxt
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	DateLayout = "2006-01-02"
+)
+
+var priorityPattern = regexp.MustCompile(`^\([A-Z]\)$`)
+
+type Task struct {
+	Done           bool
+	Priority       string
+	CompletionDate string
+	CreationDate   string
+	Description    string
+	Projects       []string
+	Contexts       []string
+	Extras         map[string]string
+	words          []string
+}
+
+func Parse(line string) (*Task, error) {
+	words := strings.Fields(line)
+	if len(words) == 0 {
+		return nil, errors.New("task is empty")
+	}
+	task := &Task{Extras: make(map[string]string)}
+	if words[0] == "x" {
+		task.Done = true
+		words = words[1:]
+	}
+	if len(words) > 0 && priorityPattern.MatchString(words[0]) {
+		task.Priority = words[0][1:2]
+		words = words[1:]
+	}
+	if task.Done && len(words) > 0 && isDate(words[0]) {
+		task.CompletionDate = words[0]
+		words = words[1:]
+	}
+	if len(words) > 0 && isDate(words[0]) {
+		task.CreationDate = words[0]
+		words = words[1:]
+	}
+
+	task.words = words
+	description := make([]string, 0, len(words))
+	for _, word := range words {
+		switch {
+		case isProject(word):
+			task.Projects = append(task.Projects, word[1:])
+		case isContext(word):
+			task.Contexts = append(task.Contexts, word[1:])
+		default:
+			key, value, ok := cutExtra(word)
+			if !ok {
+				description = append(description, word)
+				continue
+			}
+			task.Extras[key] = value
+		}
+	}
+	task.Description = strings.Join(description, " ")
+	return task, nil
+}
+
+func (t *Task) Clone() *Task {
+	clone := *t
+	clone.Projects = slices.Clone(t.Projects)
+	clone.Contexts = slices.Clone(t.Contexts)
+	clone.Extras = maps.Clone(t.Extras)
+	return &clone
+}
+
+func (t *Task) String() string {
+	words := make([]string, 0)
+	if t.Done {
+		words = append(words, "x")
+	}
+	if t.Priority != "" && !t.Done {
+		words = append(words, "("+t.Priority+")")
+	}
+	if t.Done && t.CompletionDate != "" {
+		words = append(words, t.CompletionDate)
+	}
+	if t.CreationDate != "" {
+		words = append(words, t.CreationDate)
+	}
+	return strings.Join(append(words, t.body()...), " ")
+}
+
+// body keeps the words of a parsed task where they were and only appends what has been added since.
+func (t *Task) body() []string {
+	projects := slices.Clone(t.Projects)
+	contexts := slices.Clone(t.Contexts)
+	extras := maps.Clone(t.Extras)
+	description := strings.Fields(t.Description)
+	originalDescription := make([]string, 0, len(t.words))
+	for _, word := range t.words {
+		if !isProject(word) && !isContext(word) {
+			if _, _, ok := cutExtra(word); !ok {
+				originalDescription = append(originalDescription, word)
+			}
+		}
+	}
+	keepDescription := slices.Equal(description, originalDescription)
+
+	words := make([]string, 0, len(t.words))
+	for _, word := range t.words {
+		switch {
+		case isProject(word):
+			if i := slices.Index(projects, word[1:]); i >= 0 {
+				words = append(words, word)
+				projects = slices.Delete(projects, i, i+1)
+			}
+		case isContext(word):
+			if i := slices.Index(contexts, word[1:]); i >= 0 {
+				words = append(words, word)
+				contexts = slices.Delete(contexts, i, i+1)
+			}
+		default:
+			if key, _, ok := cutExtra(word); ok {
+				if value, ok := extras[key]; ok {
+					words = append(words, key+":"+value)
+					delete(extras, key)
+				}
+			} else if keepDescription {
+				words = append(words, word)
+			} else if description != nil {
+				words = append(words, description...)
+				description = nil
+			}
+		}
+	}
+	if !keepDescription {
+		words = append(description, words...)
+	}
+	for _, project := range projects {
+		words = append(words, "+"+project)
+	}
+	for _, context := range contexts {
+		words = append(words, "@"+context)
+	}
+	keys := make([]string, 0, len(extras))
+	for key := range extras {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		words = append(words, key+":"+extras[key])
+	}
+	return words
+}
+
+func ValidateDescription(description string) error {
+	words := strings.Fields(description)
+	if len(words) > 0 && (words[0] == "x" || priorityPattern.MatchString(words[0]) || isDate(words[0])) {
+		return fmt.Errorf("%q would be read back as the start of a todo.txt task", words[0])
+	}
+	for _, word := range words {
+		if _, _, ok := cutExtra(word); ok {
+			return fmt.Errorf("%q would be read back as a todo.txt key:value tag", word)
+		}
+	}
+	return nil
+}
+
+func cutExtra(word string) (string, string, bool) {
+	key, value, ok := strings.Cut(word, ":")
+	if !ok || key == "" || value == "" || strings.HasPrefix(value, "//") || !unicode.IsLetter([]rune(key)[0]) {
+		return "", "", false
+	}
+	return key, value, true
+}
+
+func isProject(word string) bool {
+	return len(word) > 1 && word[0] == '+'
+}
+
+func isContext(word string) bool {
+	return len(word) > 1 && word[0] == '@'
+}
+
+func isDate(word string) bool {
+	_, err := time.Parse(DateLayout, word)
+	return err == nil
+}
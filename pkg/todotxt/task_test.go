@@ -0,0 +1,107 @@
+package todotxt
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestParseStringRoundTrip(t *testing.T) {
+	lines := []string{
+		"call mom",
+		"(A) call mom",
+		"(B) 2026-10-01 call   mom @phone +family due:2026-10-20",
+		"x 2026-10-03 2026-10-01 pay rent pri:A",
+		"x pay rent",
+		"see http://example.com for details +web",
+		"2026-10-01 +project first then words @ctx later:yes",
+		"status:waiting id:k4bt3shg blocked:a1,b2 wait for review",
+	}
+	for _, line := range lines {
+		t.Run(line, func(t *testing.T) {
+			task, err := Parse(line)
+			if err != nil {
+				t.Fatalf("Parse returned error: %v", err)
+			}
+			want := line
+			if line == "(B) 2026-10-01 call   mom @phone +family due:2026-10-20" {
+				want = "(B) 2026-10-01 call mom @phone +family due:2026-10-20"
+			}
+			if got := task.String(); got != want {
+				t.Errorf("String() = %q, want %q", got, want)
+			}
+			if got := task.Clone().String(); got != want {
+				t.Errorf("Clone().String() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	task, err := Parse("x (A) 2026-10-03 2026-10-01 pay rent +home @bank due:2026-10-05")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	if !task.Done || task.Priority != "A" || task.CompletionDate != "2026-10-03" || task.CreationDate != "2026-10-01" {
+		t.Errorf("Parse read the prefix as done=%v priority=%q completed=%q created=%q", task.Done, task.Priority, task.CompletionDate, task.CreationDate)
+	}
+	if task.Description != "pay rent" {
+		t.Errorf("Description = %q, want %q", task.Description, "pay rent")
+	}
+	if !slices.Equal(task.Projects, []string{"home"}) || !slices.Equal(task.Contexts, []string{"bank"}) {
+		t.Errorf("Projects = %v, Contexts = %v", task.Projects, task.Contexts)
+	}
+	if want := map[string]string{"due": "2026-10-05"}; !maps.Equal(task.Extras, want) {
+		t.Errorf("Extras = %v, want %v", task.Extras, want)
+	}
+	if _, err := Parse("   "); err == nil {
+		t.Error("Parse of a blank line returned no error")
+	}
+}
+
+func TestStringKeepsWordOrder(t *testing.T) {
+	task, err := Parse("(C) +work write report @desk due:2026-10-20 later:yes")
+	if err != nil {
+		t.Fatalf("Parse returned error: %v", err)
+	}
+	task.Description = "write summary"
+	task.Contexts = nil
+	task.Projects = append(task.Projects, "q4")
+	task.Extras["due"] = "2026-10-21"
+	task.Extras["id"] = "k4bt3shg"
+	want := "(C) +work write summary due:2026-10-21 later:yes +q4 id:k4bt3shg"
+	if got := task.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestFieldsRoundTrip(t *testing.T) {
+	fields := Fields{
+		Priority:   "A",
+		Status:     "cancelled",
+		Due:        "2026-10-20",
+		Recurrence: "weekly:mon,fri",
+		Notes:      "see http://example.com/a b, 100%",
+		ID:         "k4bt3shg",
+		Parent:     "m9cq2zxd",
+		BlockedBy:  []string{"p7dr5wnv", "t3e498ea"},
+	}
+	task := &Task{Done: true, Description: "closed", Extras: map[string]string{"later": "yes"}}
+	task.SetFields(fields)
+
+	parsed, err := Parse(task.String())
+	if err != nil {
+		t.Fatalf("Parse(%q) returned error: %v", task.String(), err)
+	}
+	got, extras := parsed.Fields()
+	if got.Priority != fields.Priority || got.Status != fields.Status || got.Due != fields.Due || got.Recurrence != fields.Recurrence ||
+		got.Notes != fields.Notes || got.ID != fields.ID || got.Parent != fields.Parent || !slices.Equal(got.BlockedBy, fields.BlockedBy) {
+		t.Errorf("Fields() = %+v, want %+v", got, fields)
+	}
+	if want := map[string]string{"later": "yes"}; !maps.Equal(extras, want) {
+		t.Errorf("remaining extras = %v, want %v", extras, want)
+	}
+	if _, ok := parsed.Extras["note"]; !ok {
+		t.Errorf("Fields() took the keys out of the parsed task: %v", parsed.Extras)
+	}
+}
@@ -1,29 +1,49 @@
 package utility
 
 import (
+	"errors"
+	"fmt"
+	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/yanosea/gct/pkg/proxy"
 )
 
+const (
+	lockFileSuffix    = ".lock"
+	lockRetryInterval = 50 * time.Millisecond
+)
+
+var (
+	ErrLocked      = errors.New("file is locked")
+	ErrLockTimeout = errors.New("timed out waiting for the lock")
+)
+
 type FileUtil interface {
 	GetXDGDataHome() (string, error)
 	MkdirIfNotExist(dirPath string) error
 	InitializeJSONFile(filePath string, emptyData any) error
+	Lock(lockFilePath string, timeout time.Duration) (func() error, error)
+	WithLock(filePath string, timeout time.Duration, fn func() error) error
+	WriteFileAtomic(filePath string, data []byte, perm os.FileMode) error
 }
 
 type fileUtil struct {
-	os   proxy.Os
-	json proxy.Json
+	os    proxy.Os
+	json  proxy.Json
+	flock proxy.Flock
 }
 
 func NewFileUtil(
 	os proxy.Os,
 	json proxy.Json,
+	flock proxy.Flock,
 ) FileUtil {
 	return &fileUtil{
-		os:   os,
-		json: json,
+		os:    os,
+		json:  json,
+		flock: flock,
 	}
 }
 
@@ -54,8 +74,84 @@ func (f *fileUtil) InitializeJSONFile(filePath string, emptyData any) error {
 		return err
 	}
 
-	if err := f.os.WriteFile(filePath, file, 0644); err != nil {
+	if err := f.WriteFileAtomic(filePath, file, 0644); err != nil {
 		return err
 	}
 	return nil
 }
+
+func (f *fileUtil) Lock(lockFilePath string, timeout time.Duration) (func() error, error) {
+	file, err := f.os.OpenFile(lockFilePath, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		locked, err := f.flock.TryLock(file)
+		if err != nil {
+			_ = file.Close()
+			return nil, err
+		}
+		if locked {
+			break
+		}
+		if !time.Now().Before(deadline) {
+			_ = file.Close()
+			return nil, ErrLockTimeout
+		}
+		time.Sleep(lockRetryInterval)
+	}
+
+	return func() error {
+		if err := f.flock.Unlock(file); err != nil {
+			_ = file.Close()
+			return err
+		}
+		return file.Close()
+	}, nil
+}
+
+func (f *fileUtil) WithLock(filePath string, timeout time.Duration, fn func() error) (err error) {
+	unlock, err := f.Lock(filePath+lockFileSuffix, timeout)
+	if err != nil {
+		if errors.Is(err, ErrLockTimeout) {
+			return fmt.Errorf("%w: another gct process is using %s (waited %s)", ErrLocked, filePath, timeout)
+		}
+		return err
+	}
+	defer func() {
+		if unlockErr := unlock(); err == nil {
+			err = unlockErr
+		}
+	}()
+
+	return fn()
+}
+
+func (f *fileUtil) WriteFileAtomic(filePath string, data []byte, perm os.FileMode) (err error) {
+	file, err := f.os.CreateTemp(filepath.Dir(filePath), "."+filepath.Base(filePath)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err != nil {
+			_ = file.Close()
+			_ = f.os.Remove(file.Name())
+		}
+	}()
+
+	if _, err = file.Write(data); err != nil {
+		return err
+	}
+	if err = file.Sync(); err != nil {
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+	if err = f.os.Chmod(file.Name(), perm); err != nil {
+		return err
+	}
+	return f.os.Rename(file.Name(), filePath)
+}